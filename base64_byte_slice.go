@@ -0,0 +1,98 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+)
+
+// Base64ByteSlice is a ByteSlice that is stored in the database
+// as base64 text instead of raw bytes. It can be used for text
+// columns written by older versions of ByteSlice.
+type Base64ByteSlice ByteSlice
+
+// Interface implements the nullable interface. It returns nil if
+// the byte slice is not valid, otherwise it returns the byte slice value.
+func (ns Base64ByteSlice) Interface() interface{} {
+	return ByteSlice(ns).Interface()
+}
+
+// NewBase64ByteSlice returns a new, properly instantiated
+// Base64ByteSlice object.
+func NewBase64ByteSlice(b []byte) Base64ByteSlice {
+	return Base64ByteSlice{ByteSlice: b, Valid: true}
+}
+
+// Scan implements the Scanner interface. Both string and []byte
+// values are decoded as base64 text, as drivers return text
+// columns as either. Raw binary that is not valid base64, i.e.
+// from a BYTEA/BLOB column, is rejected; use ByteSlice for those.
+func (ns *Base64ByteSlice) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		ns.ByteSlice, ns.Valid = nil, false
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("nulls: cannot scan %T into Base64ByteSlice", value)
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("nulls: cannot scan %s into Base64ByteSlice: %w", excerpt(s), err)
+	}
+	ns.ByteSlice, ns.Valid = b, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (ns Base64ByteSlice) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return base64.StdEncoding.EncodeToString(ns.ByteSlice), nil
+}
+
+// MarshalJSON marshals the underlying value to a JSON
+// string encoded with ByteSliceEncoding.
+func (ns Base64ByteSlice) MarshalJSON() ([]byte, error) {
+	return ByteSlice(ns).MarshalJSON()
+}
+
+// UnmarshalJSON will unmarshal a JSON string encoded
+// with ByteSliceEncoding into the byte slice.
+func (ns *Base64ByteSlice) UnmarshalJSON(text []byte) error {
+	return decoded(ns, (*ByteSlice)(ns).UnmarshalJSON(text))
+}
+
+// MarshalText marshals the underlying value to text
+// encoded with ByteSliceEncoding.
+func (ns Base64ByteSlice) MarshalText() ([]byte, error) {
+	return ByteSlice(ns).MarshalText()
+}
+
+// UnmarshalText will unmarshal text encoded with
+// ByteSliceEncoding into the byte slice.
+func (ns *Base64ByteSlice) UnmarshalText(text []byte) error {
+	return decoded(ns, (*ByteSlice)(ns).UnmarshalText(text))
+}
+
+func (ns Base64ByteSlice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ByteSlice(ns).MarshalXML(e, start)
+}
+
+func (ns *Base64ByteSlice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return decoded(ns, (*ByteSlice)(ns).UnmarshalXML(d, start))
+}
+
+func (ns Base64ByteSlice) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return ByteSlice(ns).MarshalXMLAttr(name)
+}
+
+func (ns *Base64ByteSlice) UnmarshalXMLAttr(attr xml.Attr) error {
+	return decoded(ns, (*ByteSlice)(ns).UnmarshalXMLAttr(attr))
+}
@@ -1,13 +1,33 @@
 package nulls
 
 import (
-	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"strconv"
+	"strings"
 )
 
+// BoolTokens is the truth table Bool and Null[bool] use for
+// Scan, JSON, text, XML and the schema converter. Tokens are
+// matched case insensitively, so keys must be lower case.
+// Change it at init time only.
+var BoolTokens = map[string]bool{
+	"true":  true,
+	"t":     true,
+	"yes":   true,
+	"y":     true,
+	"on":    true,
+	"1":     true,
+	"false": false,
+	"f":     false,
+	"no":    false,
+	"n":     false,
+	"off":   false,
+	"0":     false,
+}
+
 // Bool replaces sql.NullBool with an implementation
 // that supports proper JSON encoding/decoding.
 type Bool struct {
@@ -18,10 +38,7 @@ type Bool struct {
 // Interface implements the nullable interface. It returns nil if
 // the bool is not valid, otherwise it returns the bool value.
 func (ns Bool) Interface() interface{} {
-	if !ns.Valid {
-		return nil
-	}
-	return ns.Bool
+	return ns.null().Interface()
 }
 
 // NewBool returns a new, properly instantiated
@@ -30,64 +47,75 @@ func NewBool(b bool) Bool {
 	return Bool{Bool: b, Valid: true}
 }
 
-// Scan implements the Scanner interface.
+func (ns Bool) null() Null[bool] {
+	return Null[bool]{V: ns.Bool, Valid: ns.Valid}
+}
+
+// Scan implements the Scanner interface. Strings, []byte
+// and int64 are looked up in BoolTokens.
 func (ns *Bool) Scan(value interface{}) error {
-	n := sql.NullBool{Bool: ns.Bool}
-	err := n.Scan(value)
-	ns.Bool, ns.Valid = n.Bool, n.Valid
-	return err
+	ns.Bool, ns.Valid = false, false
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case bool:
+		ns.Bool, ns.Valid = v, true
+		return nil
+	case int64:
+		return ns.parse(strconv.FormatInt(v, 10))
+	case string:
+		return ns.parse(v)
+	case []byte:
+		return ns.parse(string(v))
+	}
+	return fmt.Errorf("nulls: cannot scan %T into Bool", value)
 }
 
 // Value implements the driver Valuer interface.
 func (ns Bool) Value() (driver.Value, error) {
-	if !ns.Valid {
-		return nil, nil
-	}
-	return ns.Bool, nil
+	return ns.null().Value()
 }
 
 // MarshalJSON marshals the underlying value to a
 // proper JSON representation.
 func (ns Bool) MarshalJSON() ([]byte, error) {
-	if ns.Valid {
-		return json.Marshal(ns.Bool)
-	}
-	return json.Marshal(nil)
+	return ns.null().MarshalJSON()
 }
 
 // UnmarshalJSON will unmarshal a JSON value into
-// the proper representation of that value. The strings
-// "true" and "t" will be considered "true", "false" and "f" will
-// be treated as "false". All other values will
-//be set to null by Valid = false
+// the proper representation of that value. Booleans,
+// numbers and strings are looked up in BoolTokens.
 func (ns *Bool) UnmarshalJSON(text []byte) error {
+	ns.Bool, ns.Valid = false, false
 	t := string(text)
-	if t == "true" || t == "t" {
-		ns.Valid = true
-		ns.Bool = true
+	if t == "null" {
 		return nil
 	}
-	if t == "false" || t == "f" {
-		ns.Valid = true
-		ns.Bool = false
-		return nil
+	if len(t) > 1 && t[0] == '"' {
+		if err := json.Unmarshal(text, &t); err != nil {
+			return decoded(ns, &DecodeError{Type: "Bool", Text: string(text), Err: err})
+		}
+	}
+	if err := ns.parse(t); err != nil {
+		return decoded(ns, &DecodeError{Type: "Bool", Text: string(text)})
 	}
-	ns.Bool = false
-	ns.Valid = false
 	return nil
 }
 
 // UnmarshalText will unmarshal text value into
-// the propert representation of that value.
+// the propert representation of that value. The empty
+// string and "null" are treated as null.
 func (ns *Bool) UnmarshalText(text []byte) error {
-	return ns.UnmarshalJSON(text)
+	ns.Bool, ns.Valid = false, false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	return decoded(ns, ns.parse(t))
 }
 
 func (ns Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	if ns.Valid {
-		return e.EncodeElement(ns.Bool, start)
-	}
-	return nil
+	return ns.null().MarshalXML(e, start)
 }
 
 func (ns *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
@@ -97,52 +125,31 @@ func (ns *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	if err != nil {
 		return err
 	}
-	if data == "" {
+	if data == "" || data == "null" {
 		return nil
 	}
 
-	val, err := strconv.ParseBool(data)
-
-	if err != nil {
-		return err
-	}
-
-	ns.Valid = true
-	ns.Bool = val
-
-	return nil
+	return decoded(ns, ns.parse(data))
 }
 
 func (ns Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
-	if ns.Valid {
-		var value string
-		if ns.Bool {
-			value = "true"
-		} else {
-			value = "false"
-		}
-
-		return xml.Attr{
-			Name:  name,
-			Value: value,
-		}, nil
-	}
-	return xml.Attr{}, nil
+	return ns.null().MarshalXMLAttr(name)
 }
 
 func (ns *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
-	if attr.Value == "" {
+	if attr.Value == "" || attr.Value == "null" {
 		return nil
 	}
 
-	val, err := strconv.ParseBool(attr.Value)
+	return decoded(ns, ns.parse(attr.Value))
+}
 
-	if err != nil {
-		return err
+// parse sets the bool from the BoolTokens entry for s.
+func (ns *Bool) parse(s string) error {
+	b, ok := BoolTokens[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return &DecodeError{Type: "Bool", Text: s}
 	}
-
-	ns.Valid = true
-	ns.Bool = val
-
+	ns.Bool, ns.Valid = b, true
 	return nil
 }
@@ -1,8 +1,11 @@
 package nulls
 
 import (
-	"testing"
+	"encoding/json"
 	"encoding/xml"
+	"reflect"
+	"testing"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,7 +60,6 @@ func TestBoolValid_MarshalXMLAttr(t *testing.T) {
 	assert.Equal(t, "<test val=\"true\"></test>", string(body))
 }
 
-
 func TestBoolInvalid_MarshalXMLAttr(t *testing.T) {
 	type test struct {
 		Val Bool `xml:"val,attr"`
@@ -71,4 +73,85 @@ func TestBoolInvalid_MarshalXMLAttr(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, "<test></test>", string(body))
-}
\ No newline at end of file
+}
+
+func TestBool_Tokens(t *testing.T) {
+	for token, want := range map[string]bool{
+		"true": true, "T": true, "Yes": true, "y": true, "ON": true, "1": true,
+		"false": false, "F": false, "NO": false, "n": false, "off": false, "0": false,
+	} {
+		var ns Bool
+		assert.NoError(t, ns.Scan(token), token)
+		assert.Equal(t, NewBool(want), ns, token)
+
+		ns = Bool{}
+		assert.NoError(t, ns.Scan([]byte(token)), token)
+		assert.Equal(t, NewBool(want), ns, token)
+
+		ns = Bool{}
+		assert.NoError(t, ns.UnmarshalText([]byte(token)), token)
+		assert.Equal(t, NewBool(want), ns, token)
+
+		ns = Bool{}
+		assert.NoError(t, json.Unmarshal([]byte(`"`+token+`"`), &ns), token)
+		assert.Equal(t, NewBool(want), ns, token)
+
+		var x struct {
+			Val Bool `xml:"val"`
+		}
+		assert.NoError(t, xml.Unmarshal([]byte("<x><val>"+token+"</val></x>"), &x), token)
+		assert.Equal(t, NewBool(want), x.Val, token)
+
+		var a struct {
+			Val Bool `xml:"val,attr"`
+		}
+		assert.NoError(t, xml.Unmarshal([]byte(`<x val="`+token+`"></x>`), &a), token)
+		assert.Equal(t, NewBool(want), a.Val, token)
+	}
+}
+
+func TestBool_Scan(t *testing.T) {
+	var ns Bool
+	assert.NoError(t, ns.Scan(true))
+	assert.Equal(t, NewBool(true), ns)
+	assert.NoError(t, ns.Scan(int64(0)))
+	assert.Equal(t, NewBool(false), ns)
+	assert.NoError(t, ns.Scan(nil))
+	assert.False(t, ns.Valid)
+
+	assert.Error(t, ns.Scan(int64(2)))
+	assert.Error(t, ns.Scan("maybe"))
+	assert.Error(t, ns.Scan(1.5))
+}
+
+func TestBool_UnmarshalJSON(t *testing.T) {
+	for text, want := range map[string]Bool{
+		`true`:  NewBool(true),
+		`false`: NewBool(false),
+		`1`:     NewBool(true),
+		`0`:     NewBool(false),
+		`null`:  {},
+	} {
+		ns := NewBool(true)
+		assert.NoError(t, json.Unmarshal([]byte(text), &ns), text)
+		assert.Equal(t, want, ns, text)
+	}
+
+	var ns Bool
+	err := json.Unmarshal([]byte(`"maybe"`), &ns)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Bool")
+	assert.Contains(t, err.Error(), "maybe")
+}
+
+func TestBool_Schema(t *testing.T) {
+	converters := map[reflect.Type]func(string) reflect.Value{}
+	RegisterWithSchema(func(i interface{}, fn func(string) reflect.Value) {
+		converters[reflect.TypeOf(i)] = fn
+	})
+
+	conv := converters[reflect.TypeOf(Bool{})]
+	assert.Equal(t, NewBool(true), conv("on").Interface())
+	assert.Equal(t, NewBool(false), conv("N").Interface())
+	assert.Equal(t, Bool{}, conv("maybe").Interface())
+}
@@ -1,12 +1,48 @@
 package nulls
 
 import (
-	"database/sql"
+	"bytes"
 	"database/sql/driver"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
 )
 
+// BinaryEncoding converts a byte slice to and from its
+// text representation. *base64.Encoding implements it.
+type BinaryEncoding interface {
+	EncodeToString(src []byte) string
+	DecodeString(s string) ([]byte, error)
+}
+
+type hexEncoding struct{}
+
+func (hexEncoding) EncodeToString(src []byte) string {
+	return hex.EncodeToString(src)
+}
+
+func (hexEncoding) DecodeString(s string) ([]byte, error) {
+	return hex.DecodeString(s)
+}
+
+// HexEncoding is the lower case hexadecimal BinaryEncoding.
+var HexEncoding BinaryEncoding = hexEncoding{}
+
+// ByteSliceEncoding is the encoding ByteSlice uses for
+// JSON, text and XML. It can be set to any of base64.StdEncoding,
+// base64.URLEncoding, base64.RawStdEncoding,
+// base64.RawURLEncoding or HexEncoding.
+var ByteSliceEncoding BinaryEncoding = base64.StdEncoding
+
+// ScanLegacyBase64 makes ByteSlice.Scan decode []byte values
+// that are padded base64 text, as older versions of ByteSlice
+// stored, instead of taking them as raw bytes. Raw bytes that
+// happen to be valid base64 are decoded as well, so set it to
+// false once the base64 rows have been migrated.
+var ScanLegacyBase64 = true
+
 // ByteSlice adds an implementation for []byte
 // that supports proper JSON encoding/decoding.
 type ByteSlice struct {
@@ -29,51 +65,148 @@ func NewByteSlice(b []byte) ByteSlice {
 	return ByteSlice{ByteSlice: b, Valid: true}
 }
 
-// Scan implements the Scanner interface.
+// Scan implements the Scanner interface. It accepts both the
+// raw bytes of BYTEA/BLOB columns and the base64 text older
+// versions stored. []byte values, which drivers such as MySQL
+// return for TEXT columns too, are decoded if they are base64
+// text and ScanLegacyBase64 is set, and stored as is otherwise.
+// string values are always taken to be base64 text.
 func (ns *ByteSlice) Scan(value interface{}) error {
-	n := sql.NullString{String: base64.StdEncoding.EncodeToString(ns.ByteSlice)}
-	err := n.Scan(value)
-	if err != nil {
-		return err
+	switch v := value.(type) {
+	case nil:
+		ns.ByteSlice, ns.Valid = nil, false
+		return nil
+	case []byte:
+		if b, ok := legacyBase64(v); ok {
+			ns.ByteSlice, ns.Valid = b, true
+			return nil
+		}
+		ns.ByteSlice, ns.Valid = append([]byte{}, v...), true
+		return nil
+	case string:
+		b, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return fmt.Errorf("nulls: cannot scan %q into ByteSlice: %w", v, err)
+		}
+		ns.ByteSlice, ns.Valid = b, true
+		return nil
 	}
-	//ns.Float32, ns.Valid = float32(n.Float64), n.Valid
-	ns.ByteSlice, err = base64.StdEncoding.DecodeString(n.String)
-	ns.Valid = n.Valid
-	return err
+	return fmt.Errorf("nulls: cannot scan %T into ByteSlice", value)
 }
 
-// Value implements the driver Valuer interface.
+// legacyBase64 decodes v if ScanLegacyBase64 is set and v is
+// padded base64 text. Line breaks, which the decoder skips,
+// mark v as raw bytes.
+func legacyBase64(v []byte) ([]byte, bool) {
+	if !ScanLegacyBase64 || len(v) == 0 || bytes.ContainsAny(v, "\r\n") {
+		return nil, false
+	}
+	b, err := base64.StdEncoding.Strict().DecodeString(string(v))
+	return b, err == nil
+}
+
+// Value implements the driver Valuer interface. The
+// bytes are passed to the driver as is.
 func (ns ByteSlice) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return base64.StdEncoding.EncodeToString(ns.ByteSlice), nil
+	if ns.ByteSlice == nil {
+		return []byte{}, nil
+	}
+	return ns.ByteSlice, nil
 }
 
-// MarshalJSON marshals the underlying value to a
-// proper JSON representation.
+// MarshalJSON marshals the underlying value to a JSON
+// string encoded with ByteSliceEncoding.
 func (ns ByteSlice) MarshalJSON() ([]byte, error) {
 	if ns.Valid {
-		return json.Marshal(ns.ByteSlice)
+		return json.Marshal(ByteSliceEncoding.EncodeToString(ns.ByteSlice))
 	}
 	return json.Marshal(nil)
 }
 
-// UnmarshalJSON will unmarshal a JSON value into
-// the propert representation of that value.
+// UnmarshalJSON will unmarshal a JSON string encoded
+// with ByteSliceEncoding into the byte slice.
 func (ns *ByteSlice) UnmarshalJSON(text []byte) error {
 	ns.Valid = false
 	if string(text) == "null" {
 		return nil
 	}
 
-	ns.ByteSlice = text
-	ns.Valid = true
-	return nil
+	var s string
+	if err := json.Unmarshal(text, &s); err != nil {
+		return decoded(ns, &DecodeError{Type: "ByteSlice", Text: string(text), Err: err})
+	}
+	return decoded(ns, ns.decode(s))
 }
 
-// UnmarshalText will unmarshal text value into
-// the propert representation of that value.
+// MarshalText marshals the underlying value to text
+// encoded with ByteSliceEncoding.
+func (ns ByteSlice) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	return []byte(ByteSliceEncoding.EncodeToString(ns.ByteSlice)), nil
+}
+
+// UnmarshalText will unmarshal text encoded with
+// ByteSliceEncoding into the byte slice.
 func (ns *ByteSlice) UnmarshalText(text []byte) error {
-	return ns.UnmarshalJSON(text)
+	ns.Valid = false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	return decoded(ns, ns.decode(t))
+}
+
+func (ns ByteSlice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if ns.Valid {
+		return e.EncodeElement(ByteSliceEncoding.EncodeToString(ns.ByteSlice), start)
+	}
+	return nil
+}
+
+func (ns *ByteSlice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.decode(data))
+}
+
+func (ns ByteSlice) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if ns.Valid {
+		return xml.Attr{
+			Name:  name,
+			Value: ByteSliceEncoding.EncodeToString(ns.ByteSlice),
+		}, nil
+	}
+	return xml.Attr{}, nil
+}
+
+func (ns *ByteSlice) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.decode(attr.Value))
+}
+
+// decode sets the byte slice from s encoded with ByteSliceEncoding.
+func (ns *ByteSlice) decode(s string) error {
+	b, err := ByteSliceEncoding.DecodeString(s)
+	if err != nil {
+		return &DecodeError{Type: "ByteSlice", Text: s, Err: err}
+	}
+	ns.ByteSlice = b
+	ns.Valid = true
+	return nil
 }
@@ -0,0 +1,190 @@
+package nulls
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestByteSliceValid_MarshalXML(t *testing.T) {
+	type test struct {
+		Elem ByteSlice `xml:"elem"`
+		Attr ByteSlice `xml:"attr,attr"`
+	}
+
+	val := test{
+		Elem: NewByteSlice([]byte("hi?")),
+		Attr: NewByteSlice([]byte("hi?")),
+	}
+
+	data, err := xml.Marshal(val)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "<test attr=\"aGk/\"><elem>aGk/</elem></test>", string(data))
+}
+
+func TestByteSliceInvalid_MarshalXML(t *testing.T) {
+	type test struct {
+		Elem ByteSlice `xml:"elem"`
+		Attr ByteSlice `xml:"attr,attr"`
+	}
+
+	data, err := xml.Marshal(test{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "<test></test>", string(data))
+}
+
+func TestByteSlice_Encodings(t *testing.T) {
+	defer func(enc BinaryEncoding) { ByteSliceEncoding = enc }(ByteSliceEncoding)
+
+	type test struct {
+		Elem ByteSlice `xml:"elem"`
+		Attr ByteSlice `xml:"attr,attr"`
+	}
+
+	raw := []byte{0xfb, 0xff}
+	for enc, want := range map[BinaryEncoding]string{
+		base64.StdEncoding:    "+/8=",
+		base64.URLEncoding:    "-_8=",
+		base64.RawStdEncoding: "+/8",
+		base64.RawURLEncoding: "-_8",
+		HexEncoding:           "fbff",
+	} {
+		ByteSliceEncoding = enc
+		val := test{Elem: NewByteSlice(raw), Attr: NewByteSlice(raw)}
+
+		data, err := xml.Marshal(val)
+		assert.NoError(t, err)
+		assert.Equal(t, "<test attr=\""+want+"\"><elem>"+want+"</elem></test>", string(data))
+
+		var out test
+		assert.NoError(t, xml.Unmarshal(data, &out))
+		assert.Equal(t, val, out)
+
+		data, err = val.Elem.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, "\""+want+"\"", string(data))
+
+		var ns ByteSlice
+		assert.NoError(t, ns.UnmarshalJSON(data))
+		assert.Equal(t, val.Elem, ns)
+
+		ns = ByteSlice{}
+		assert.NoError(t, ns.UnmarshalText([]byte(want)))
+		assert.Equal(t, val.Elem, ns)
+	}
+}
+
+func TestByteSlice_JSONRoundTrip(t *testing.T) {
+	for _, val := range []ByteSlice{
+		{},
+		NewByteSlice([]byte{}),
+		NewByteSlice([]byte("hello")),
+		NewByteSlice([]byte{0x00, 0x01, 0xfe, 0xff, '"', '\\'}),
+	} {
+		data, err := json.Marshal(val)
+		assert.NoError(t, err)
+
+		var out ByteSlice
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, val.Valid, out.Valid)
+		assert.Equal(t, string(val.ByteSlice), string(out.ByteSlice))
+	}
+}
+
+func TestByteSlice_UnmarshalJSON(t *testing.T) {
+	var ns ByteSlice
+
+	assert.NoError(t, ns.UnmarshalJSON([]byte(`"aGVsbG8="`)))
+	assert.Equal(t, true, ns.Valid)
+	assert.Equal(t, []byte("hello"), ns.ByteSlice)
+
+	assert.NoError(t, ns.UnmarshalJSON([]byte(`null`)))
+	assert.Equal(t, false, ns.Valid)
+
+	assert.Error(t, ns.UnmarshalJSON([]byte(`"not base64!"`)))
+	assert.Equal(t, false, ns.Valid)
+
+	assert.Error(t, ns.UnmarshalJSON([]byte(`42`)))
+	assert.Equal(t, false, ns.Valid)
+}
+
+func TestByteSlice_UnmarshalText(t *testing.T) {
+	var ns ByteSlice
+
+	assert.NoError(t, ns.UnmarshalText([]byte("aGVsbG8=")))
+	assert.Equal(t, true, ns.Valid)
+	assert.Equal(t, []byte("hello"), ns.ByteSlice)
+
+	assert.NoError(t, ns.UnmarshalText([]byte("null")))
+	assert.Equal(t, false, ns.Valid)
+
+	assert.Error(t, ns.UnmarshalText([]byte("aGVsbG8")))
+	assert.Equal(t, false, ns.Valid)
+}
+
+func TestByteSlice_Scan(t *testing.T) {
+	var ns ByteSlice
+
+	raw := []byte{0x00, 0xff}
+	assert.NoError(t, ns.Scan(raw))
+	assert.Equal(t, NewByteSlice([]byte{0x00, 0xff}), ns)
+	raw[0] = 0x01
+	assert.Equal(t, byte(0x00), ns.ByteSlice[0])
+
+	assert.NoError(t, ns.Scan("AP8="))
+	assert.Equal(t, NewByteSlice([]byte{0x00, 0xff}), ns)
+
+	// legacy base64 text, i.e. a MySQL TEXT column
+	assert.NoError(t, ns.Scan([]byte("AP8=")))
+	assert.Equal(t, NewByteSlice([]byte{0x00, 0xff}), ns)
+
+	assert.NoError(t, ns.Scan([]byte("AP8\n")))
+	assert.Equal(t, NewByteSlice([]byte("AP8\n")), ns)
+
+	ScanLegacyBase64 = false
+	defer func() { ScanLegacyBase64 = true }()
+	assert.NoError(t, ns.Scan([]byte("AP8=")))
+	assert.Equal(t, NewByteSlice([]byte("AP8=")), ns)
+
+	assert.NoError(t, ns.Scan(nil))
+	assert.Equal(t, false, ns.Valid)
+
+	assert.Error(t, ns.Scan(42))
+}
+
+func TestBase64ByteSlice_Scan(t *testing.T) {
+	var ns Base64ByteSlice
+
+	assert.NoError(t, ns.Scan("AP8="))
+	assert.Equal(t, NewBase64ByteSlice([]byte{0x00, 0xff}), ns)
+
+	// MySQL returns TEXT columns as []byte
+	assert.NoError(t, ns.Scan([]byte("AP8=")))
+	assert.Equal(t, NewBase64ByteSlice([]byte{0x00, 0xff}), ns)
+
+	assert.NoError(t, ns.Scan(nil))
+	assert.Equal(t, false, ns.Valid)
+
+	assert.Error(t, ns.Scan([]byte{0x00, 0xff}))
+	assert.Error(t, ns.Scan("not base64"))
+	assert.Error(t, ns.Scan(42))
+}
+
+func TestByteSlice_Value(t *testing.T) {
+	v, err := NewByteSlice([]byte{0x00, 0xff}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0xff}, v)
+
+	v, err = NewBase64ByteSlice([]byte{0x00, 0xff}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "AP8=", v)
+
+	var ns Base64ByteSlice
+	assert.NoError(t, ns.Scan(v))
+	assert.Equal(t, NewBase64ByteSlice([]byte{0x00, 0xff}), ns)
+}
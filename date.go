@@ -0,0 +1,216 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the layout of a Date in SQL, JSON, text and XML.
+const DateLayout = "2006-01-02"
+
+// Date adds an implementation for calendar dates without
+// a time of day, i.e. DATE columns, that supports proper
+// JSON encoding/decoding.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the Date is not valid, otherwise it returns the date as a
+// time.Time at midnight UTC.
+func (ns Date) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.Time()
+}
+
+// NewDate returns a new, properly instantiated
+// Date object for the day of t in its location.
+func NewDate(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d, Valid: true}
+}
+
+// Time returns the date as a time.Time at midnight UTC.
+func (ns Date) Time() time.Time {
+	return time.Date(ns.Year, ns.Month, ns.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// String returns the date formatted as DateLayout,
+// or "null" if it is not valid.
+func (ns Date) String() string {
+	if !ns.Valid {
+		return "null"
+	}
+	return ns.Time().Format(DateLayout)
+}
+
+// AddDays returns the date n days after ns. n can be negative.
+// An invalid date stays invalid.
+func (ns Date) AddDays(n int) Date {
+	if !ns.Valid {
+		return ns
+	}
+	return NewDate(ns.Time().AddDate(0, 0, n))
+}
+
+// Compare returns -1, 0 or +1 depending on whether ns is
+// before, equal to or after other. Invalid dates sort
+// before all valid ones.
+func (ns Date) Compare(other Date) int {
+	switch {
+	case !ns.Valid && !other.Valid:
+		return 0
+	case !ns.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	return ns.Time().Compare(other.Time())
+}
+
+// Before reports whether ns is before other.
+func (ns Date) Before(other Date) bool {
+	return ns.Compare(other) < 0
+}
+
+// After reports whether ns is after other.
+func (ns Date) After(other Date) bool {
+	return ns.Compare(other) > 0
+}
+
+// Scan implements the Scanner interface. It accepts time.Time
+// as well as strings and []byte holding a date or a timestamp.
+func (ns *Date) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*ns = Date{}
+		return nil
+	case time.Time:
+		*ns = NewDate(v)
+		return nil
+	case string:
+		return ns.scanText(v)
+	case []byte:
+		return ns.scanText(string(v))
+	}
+	return fmt.Errorf("nulls: cannot scan %T into Date", value)
+}
+
+func (ns *Date) scanText(s string) error {
+	for _, layout := range timeScanLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			*ns = NewDate(t)
+			return nil
+		}
+	}
+	return fmt.Errorf("nulls: cannot scan %q into Date", s)
+}
+
+// Value implements the driver Valuer interface. The date
+// is passed as DateLayout text, so it is not shifted by
+// the time zone of the database session.
+func (ns Date) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return ns.String(), nil
+}
+
+// MarshalJSON marshals the date as a "YYYY-MM-DD" string.
+func (ns Date) MarshalJSON() ([]byte, error) {
+	if ns.Valid {
+		return json.Marshal(ns.String())
+	}
+	return json.Marshal(nil)
+}
+
+// UnmarshalJSON will unmarshal a "YYYY-MM-DD" string
+// into the date.
+func (ns *Date) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	if string(text) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(text, &s); err != nil {
+		return decoded(ns, &DecodeError{Type: "Date", Text: string(text), Err: err})
+	}
+	return decoded(ns, ns.parse(s))
+}
+
+// MarshalText marshals the date as "YYYY-MM-DD".
+func (ns Date) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	return []byte(ns.String()), nil
+}
+
+// UnmarshalText will unmarshal "YYYY-MM-DD" text
+// into the date.
+func (ns *Date) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	return decoded(ns, ns.parse(t))
+}
+
+func (ns Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if ns.Valid {
+		return e.EncodeElement(ns.String(), start)
+	}
+	return nil
+}
+
+func (ns *Date) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(data))
+}
+
+func (ns Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if ns.Valid {
+		return xml.Attr{
+			Name:  name,
+			Value: ns.String(),
+		}, nil
+	}
+	return xml.Attr{}, nil
+}
+
+func (ns *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(attr.Value))
+}
+
+// parse sets the date from s formatted as DateLayout.
+func (ns *Date) parse(s string) error {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return &DecodeError{Type: "Date", Text: s, Err: err}
+	}
+	*ns = NewDate(t)
+	return nil
+}
@@ -0,0 +1,85 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_Scan(t *testing.T) {
+	want := Date{Year: 2019, Month: time.November, Day: 25, Valid: true}
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	for _, value := range []interface{}{
+		time.Date(2019, 11, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, 11, 25, 1, 0, 0, 0, loc),
+		"2019-11-25",
+		[]byte("2019-11-25"),
+		"2019-11-25 00:00:00",
+	} {
+		var ns Date
+		assert.NoError(t, ns.Scan(value), "%v", value)
+		assert.Equal(t, want, ns)
+	}
+
+	var ns Date
+	assert.Error(t, ns.Scan("25.11.2019"))
+	assert.NoError(t, ns.Scan(nil))
+	assert.Equal(t, false, ns.Valid)
+}
+
+func TestDate_Value(t *testing.T) {
+	v, err := NewDate(time.Date(2019, 11, 25, 23, 0, 0, 0, time.UTC)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2019-11-25", v)
+}
+
+func TestDate_JSON(t *testing.T) {
+	type test struct {
+		Val  Date `json:"val"`
+		None Date `json:"none"`
+	}
+
+	val := test{Val: Date{Year: 2019, Month: time.February, Day: 3, Valid: true}}
+	data, err := json.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"val":"2019-02-03","none":null}`, string(data))
+
+	var out test
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, val, out)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"val":"2019-02-03T00:00:00Z"}`), &out))
+}
+
+func TestDate_XML(t *testing.T) {
+	type test struct {
+		Elem Date `xml:"elem"`
+		Attr Date `xml:"attr,attr"`
+	}
+
+	d := Date{Year: 2019, Month: time.February, Day: 3, Valid: true}
+	data, err := xml.Marshal(test{Elem: d, Attr: d})
+	assert.NoError(t, err)
+	assert.Equal(t, `<test attr="2019-02-03"><elem>2019-02-03</elem></test>`, string(data))
+
+	var out test
+	assert.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, d, out.Elem)
+	assert.Equal(t, d, out.Attr)
+}
+
+func TestDate_Helpers(t *testing.T) {
+	d := Date{Year: 2019, Month: time.December, Day: 31, Valid: true}
+	next := d.AddDays(1)
+
+	assert.Equal(t, Date{Year: 2020, Month: time.January, Day: 1, Valid: true}, next)
+	assert.Equal(t, d, next.AddDays(-1))
+	assert.True(t, d.Before(next))
+	assert.True(t, next.After(d))
+	assert.Equal(t, 0, d.Compare(next.AddDays(-1)))
+	assert.True(t, Date{}.Before(d))
+	assert.Equal(t, Date{}, Date{}.AddDays(1))
+}
@@ -0,0 +1,342 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// DecimalJSONString makes Decimal marshal to a quoted JSON
+// string instead of a JSON number. Decoding accepts both.
+var DecimalJSONString = false
+
+// decimalPattern matches the decimal notation Decimal accepts.
+var decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][+-]?[0-9]+)?$`)
+
+// Decimal adds an implementation for exact decimal numbers,
+// i.e. NUMERIC/DECIMAL columns, that supports proper JSON
+// encoding/decoding. The value is held by a big.Rat that is
+// never modified in place, so Decimals can be copied freely.
+type Decimal struct {
+	Decimal *big.Rat
+	Valid   bool // Valid is true if Decimal is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the decimal is not valid, otherwise it returns the *big.Rat value.
+func (ns Decimal) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.Decimal
+}
+
+// NewDecimal returns a new, properly instantiated
+// Decimal object holding a copy of r. A nil r gives
+// an invalid Decimal.
+func NewDecimal(r *big.Rat) Decimal {
+	if r == nil {
+		return Decimal{}
+	}
+	return Decimal{Decimal: new(big.Rat).Set(r), Valid: true}
+}
+
+// ParseDecimal returns the Decimal for s in decimal notation,
+// i.e. "-12.50" or "1.5e3".
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalPattern.MatchString(s) {
+		return Decimal{}, &DecodeError{Type: "Decimal", Text: s}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, &DecodeError{Type: "Decimal", Text: s}
+	}
+	return Decimal{Decimal: r, Valid: true}, nil
+}
+
+// String returns the exact decimal notation of the value,
+// or "null" if it is not valid.
+func (ns Decimal) String() string {
+	if !ns.Valid {
+		return "null"
+	}
+	s, err := ns.format()
+	if err != nil {
+		return ns.Decimal.RatString()
+	}
+	return s
+}
+
+// format returns the exact decimal notation of the value. It
+// fails for fractions such as 1/3 that have no finite one.
+func (ns Decimal) format() (string, error) {
+	r := ns.Decimal
+	if r == nil {
+		r = new(big.Rat)
+	}
+
+	// the notation is finite if the denominator is 2^a * 5^b,
+	// and then has max(a, b) fractional digits
+	d := new(big.Int).Set(r.Denom())
+	twos := d.TrailingZeroBits()
+	d.Rsh(d, twos)
+	fives := uint(0)
+	five, m := big.NewInt(5), new(big.Int)
+	for {
+		q, rem := new(big.Int).QuoRem(d, five, m)
+		if rem.Sign() != 0 {
+			break
+		}
+		d, fives = q, fives+1
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return "", fmt.Errorf("nulls: %s has no finite decimal notation", r.RatString())
+	}
+
+	scale := twos
+	if fives > scale {
+		scale = fives
+	}
+	return r.FloatString(int(scale)), nil
+}
+
+// Add returns ns + other. The result is invalid
+// if either operand is.
+func (ns Decimal) Add(other Decimal) Decimal {
+	if !ns.Valid || !other.Valid {
+		return Decimal{}
+	}
+	return Decimal{Decimal: new(big.Rat).Add(ns.rat(), other.rat()), Valid: true}
+}
+
+// Sub returns ns - other. The result is invalid
+// if either operand is.
+func (ns Decimal) Sub(other Decimal) Decimal {
+	if !ns.Valid || !other.Valid {
+		return Decimal{}
+	}
+	return Decimal{Decimal: new(big.Rat).Sub(ns.rat(), other.rat()), Valid: true}
+}
+
+// Mul returns ns * other. The result is invalid
+// if either operand is.
+func (ns Decimal) Mul(other Decimal) Decimal {
+	if !ns.Valid || !other.Valid {
+		return Decimal{}
+	}
+	return Decimal{Decimal: new(big.Rat).Mul(ns.rat(), other.rat()), Valid: true}
+}
+
+// Cmp returns -1, 0 or +1 depending on whether ns is less
+// than, equal to or greater than other. Invalid decimals
+// sort before all valid ones.
+func (ns Decimal) Cmp(other Decimal) int {
+	switch {
+	case !ns.Valid && !other.Valid:
+		return 0
+	case !ns.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	return ns.rat().Cmp(other.rat())
+}
+
+// Round returns ns rounded to places fractional digits, with
+// halves rounded away from zero. A negative places rounds to
+// tens, hundreds and so on. An invalid decimal stays invalid.
+func (ns Decimal) Round(places int) Decimal {
+	if !ns.Valid {
+		return ns
+	}
+
+	abs := places
+	if abs < 0 {
+		abs = -abs
+	}
+	pow := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs)), nil))
+
+	scaled := new(big.Rat)
+	if places >= 0 {
+		scaled.Mul(ns.rat(), pow)
+	} else {
+		scaled.Quo(ns.rat(), pow)
+	}
+
+	q, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	rem.Abs(rem).Lsh(rem, 1)
+	if rem.Cmp(scaled.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(scaled.Sign())))
+	}
+
+	r := new(big.Rat).SetInt(q)
+	if places >= 0 {
+		r.Quo(r, pow)
+	} else {
+		r.Mul(r, pow)
+	}
+	return Decimal{Decimal: r, Valid: true}
+}
+
+func (ns Decimal) rat() *big.Rat {
+	if ns.Decimal == nil {
+		return new(big.Rat)
+	}
+	return ns.Decimal
+}
+
+// Scan implements the Scanner interface. It accepts strings
+// and []byte in decimal notation, int64 and float64. A
+// float64 is taken by its shortest decimal representation.
+func (ns *Decimal) Scan(value interface{}) error {
+	var err error
+	switch v := value.(type) {
+	case nil:
+		*ns = Decimal{}
+	case int64:
+		*ns = Decimal{Decimal: new(big.Rat).SetInt64(v), Valid: true}
+	case float64:
+		*ns, err = ParseDecimal(strconv.FormatFloat(v, 'g', -1, 64))
+	case string:
+		*ns, err = ParseDecimal(v)
+	case []byte:
+		*ns, err = ParseDecimal(string(v))
+	default:
+		err = fmt.Errorf("nulls: cannot scan %T into Decimal", value)
+	}
+	return err
+}
+
+// Value implements the driver Valuer interface. The decimal
+// is passed as exact text.
+func (ns Decimal) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return ns.format()
+}
+
+// MarshalJSON marshals the decimal as a JSON number, or as a
+// JSON string if DecimalJSONString is set, without losing
+// precision.
+func (ns Decimal) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return json.Marshal(nil)
+	}
+	s, err := ns.format()
+	if err != nil {
+		return nil, err
+	}
+	if DecimalJSONString {
+		return json.Marshal(s)
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalJSON will unmarshal a JSON number, or a
+// JSON string holding a number, into the decimal.
+func (ns *Decimal) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	txt := string(text)
+	if txt == "null" {
+		return nil
+	}
+
+	if len(txt) > 1 && txt[0] == '"' {
+		if err := json.Unmarshal(text, &txt); err != nil {
+			return decoded(ns, &DecodeError{Type: "Decimal", Text: string(text), Err: err})
+		}
+	}
+	d, err := ParseDecimal(txt)
+	if err != nil {
+		return decoded(ns, err)
+	}
+	*ns = d
+	return nil
+}
+
+// MarshalText marshals the decimal to its exact text.
+func (ns Decimal) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	s, err := ns.format()
+	return []byte(s), err
+}
+
+// UnmarshalText will unmarshal text value into
+// the proper representation of that value.
+func (ns *Decimal) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	d, err := ParseDecimal(t)
+	if err != nil {
+		return decoded(ns, err)
+	}
+	*ns = d
+	return nil
+}
+
+func (ns Decimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !ns.Valid {
+		return nil
+	}
+	s, err := ns.format()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(s, start)
+}
+
+func (ns *Decimal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	v, err := ParseDecimal(data)
+	if err != nil {
+		return decoded(ns, err)
+	}
+	*ns = v
+	return nil
+}
+
+func (ns Decimal) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !ns.Valid {
+		return xml.Attr{}, nil
+	}
+	s, err := ns.format()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{
+		Name:  name,
+		Value: s,
+	}, nil
+}
+
+func (ns *Decimal) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	v, err := ParseDecimal(attr.Value)
+	if err != nil {
+		return decoded(ns, err)
+	}
+	*ns = v
+	return nil
+}
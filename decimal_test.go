@@ -0,0 +1,128 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	for value, want := range map[interface{}]string{
+		"12.50":      "12.5",
+		"-0.001":     "-0.001",
+		"1.5e3":      "1500",
+		int64(42):    "42",
+		float64(0.1): "0.1",
+		"123456789012345678901234567890.123456789": "123456789012345678901234567890.123456789",
+	} {
+		var ns Decimal
+		assert.NoError(t, ns.Scan(value), "%v", value)
+		assert.Equal(t, want, ns.String())
+	}
+
+	var ns Decimal
+	assert.NoError(t, ns.Scan([]byte("7.25")))
+	assert.Equal(t, "7.25", ns.String())
+
+	assert.Error(t, ns.Scan("1/3"))
+	assert.Error(t, ns.Scan("0x10"))
+	assert.Error(t, ns.Scan("abc"))
+	assert.NoError(t, ns.Scan(nil))
+	assert.Equal(t, false, ns.Valid)
+}
+
+func TestDecimal_Value(t *testing.T) {
+	v, err := mustDecimal("0.1").Add(mustDecimal("0.2")).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.3", v)
+
+	v, err = Decimal{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	defer func(quoted bool) { DecimalJSONString = quoted }(DecimalJSONString)
+
+	type test struct {
+		Val  Decimal `json:"val"`
+		None Decimal `json:"none"`
+	}
+
+	val := test{Val: mustDecimal("9007199254740993.01")}
+	data, err := json.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"val":9007199254740993.01,"none":null}`, string(data))
+
+	var out test
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, 0, val.Val.Cmp(out.Val))
+	assert.Equal(t, false, out.None.Valid)
+
+	DecimalJSONString = true
+	data, err = json.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"val":"9007199254740993.01","none":null}`, string(data))
+
+	out = test{}
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, 0, val.Val.Cmp(out.Val))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"val":"ten"}`), &out))
+}
+
+func TestDecimal_XML(t *testing.T) {
+	type test struct {
+		Elem Decimal `xml:"elem"`
+		Attr Decimal `xml:"attr,attr"`
+	}
+
+	val := test{Elem: mustDecimal("1.05"), Attr: mustDecimal("-3")}
+	data, err := xml.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `<test attr="-3"><elem>1.05</elem></test>`, string(data))
+
+	var out test
+	assert.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, "1.05", out.Elem.String())
+	assert.Equal(t, "-3", out.Attr.String())
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a, b := mustDecimal("10.25"), mustDecimal("0.75")
+
+	assert.Equal(t, "11", a.Add(b).String())
+	assert.Equal(t, "9.5", a.Sub(b).String())
+	assert.Equal(t, "7.6875", a.Mul(b).String())
+	assert.Equal(t, 1, a.Cmp(b))
+	assert.Equal(t, -1, b.Cmp(a))
+	assert.Equal(t, 0, a.Cmp(mustDecimal("10.250")))
+	assert.Equal(t, -1, Decimal{}.Cmp(a))
+	assert.Equal(t, false, a.Add(Decimal{}).Valid)
+
+	assert.Equal(t, "7.69", a.Mul(b).Round(2).String())
+	assert.Equal(t, "-2.5", mustDecimal("-2.45").Round(1).String())
+	assert.Equal(t, "2.4", mustDecimal("2.449").Round(1).String())
+	assert.Equal(t, "1200", mustDecimal("1249").Round(-2).String())
+	assert.Equal(t, "10.25", a.String())
+}
+
+func TestNewDecimal_Nil(t *testing.T) {
+	assert.Equal(t, Decimal{}, NewDecimal(nil))
+	assert.Equal(t, Decimal{}, New((*big.Rat)(nil)).Nullable)
+
+	v, err := New(Decimal{}).Parse((*big.Rat)(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, Decimal{}, v)
+}
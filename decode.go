@@ -0,0 +1,65 @@
+package nulls
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// LenientDecoding makes the JSON, text and XML decoders of
+// every nullable type turn malformed input into null instead
+// of returning an error. Decoding is strict by default;
+// SetLenient opts single types out.
+var LenientDecoding = false
+
+var lenientTypes = struct {
+	sync.RWMutex
+	m map[reflect.Type]bool
+}{
+	m: map[reflect.Type]bool{},
+}
+
+// SetLenient turns lenient decoding on or off for N only,
+// i.e. nulls.SetLenient[nulls.Bool](true).
+func SetLenient[N nullable](lenient bool) {
+	t := reflect.TypeOf((*N)(nil)).Elem()
+
+	lenientTypes.Lock()
+	defer lenientTypes.Unlock()
+	if lenient {
+		lenientTypes.m[t] = true
+	} else {
+		delete(lenientTypes.m, t)
+	}
+}
+
+func isLenient(t reflect.Type) bool {
+	if LenientDecoding {
+		return true
+	}
+	lenientTypes.RLock()
+	defer lenientTypes.RUnlock()
+	return lenientTypes.m[t]
+}
+
+// decoded finishes a decoder of the nullable pointed to by ns.
+// In lenient mode a failure leaves ns null and is dropped.
+// Otherwise a *DecodeError of a wrapped Null[T] is renamed
+// after the type of ns.
+func decoded(ns interface{}, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(ns).Elem()
+	if isLenient(v.Type()) {
+		v.FieldByName("Valid").SetBool(false)
+		return nil
+	}
+
+	// generic types name themselves
+	if de, ok := err.(*DecodeError); ok && !strings.Contains(v.Type().Name(), "[") {
+		return &DecodeError{Type: v.Type().Name(), Text: de.Text, Err: de.Err}
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrict_UnmarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		ptr  interface{}
+		typ  string
+	}{
+		{`123`, &String{}, "String"},
+		{`{"a":1}`, &String{}, "String"},
+		{`"abc"`, &Int{}, "Int"},
+		{`1.5`, &Int{}, "Int"},
+		{`"maybe"`, &Bool{}, "Bool"},
+		{`2`, &Bool{}, "Bool"},
+		{`"x"`, &Int64{}, "Int64"},
+		{`true`, &Float64{}, "Float64"},
+		{`"yesterday"`, &Time{}, "Time"},
+		{`"2020-01-01"`, &TimeOf[RFC3339Format]{}, "TimeOf[nulls.RFC3339Format]"},
+		{`"2020-13-01"`, &Date{}, "Date"},
+		{`5`, &Date{}, "Date"},
+		{`"25:00"`, &TimeOfDay{}, "TimeOfDay"},
+		{`"soon"`, &Duration{}, "Duration"},
+		{`"P1M"`, &Duration{}, "Duration"},
+		{`"1/3"`, &Decimal{}, "Decimal"},
+		{`"zz!"`, &UUID{}, "UUID"},
+		{`"not base64!"`, &ByteSlice{}, "ByteSlice"},
+		{`42`, &ByteSlice{}, "ByteSlice"},
+		{`"not base64!"`, &Base64ByteSlice{}, "Base64ByteSlice"},
+		{`[1]`, &JSONOf[address]{}, "JSONOf[nulls.address]"},
+	} {
+		err := json.Unmarshal([]byte(tc.text), tc.ptr)
+		require.Error(t, err, tc.text)
+
+		var de *DecodeError
+		require.True(t, errors.As(err, &de), "%s: %v", tc.text, err)
+		assert.Equal(t, tc.typ, de.Type, tc.text)
+		// the raw JSON or the string it holds
+		assert.NotEmpty(t, de.Text)
+		assert.Contains(t, tc.text, de.Text)
+		assert.Contains(t, err.Error(), tc.typ)
+	}
+
+	// JSON never sees malformed JSON from encoding/json
+	var ns JSON
+	err := ns.UnmarshalText([]byte(`{"a":`))
+	var de *DecodeError
+	require.True(t, errors.As(err, &de))
+	assert.Equal(t, "JSON", de.Type)
+	assert.Equal(t, `{"a":`, de.Text)
+}
+
+func TestStrict_UnmarshalXML(t *testing.T) {
+	type test struct {
+		Time Time `xml:"time"`
+		Int  Int  `xml:"int,attr"`
+	}
+
+	var v test
+	err := xml.Unmarshal([]byte(`<test><time>yesterday</time></test>`), &v)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `"yesterday"`)
+	assert.False(t, v.Time.Valid)
+
+	err = xml.Unmarshal([]byte(`<test int="abc"></test>`), &v)
+	var de *DecodeError
+	require.True(t, errors.As(err, &de))
+	assert.Equal(t, "Int", de.Type)
+	assert.Equal(t, "abc", de.Text)
+}
+
+func TestStrict_Null(t *testing.T) {
+	var ns Null[int16]
+	err := ns.UnmarshalText([]byte("abc"))
+	var de *DecodeError
+	require.True(t, errors.As(err, &de))
+	assert.Equal(t, "Null[int16]", de.Type)
+
+	// out of range numbers keep their own error
+	var re *RangeError
+	assert.True(t, errors.As(ns.UnmarshalText([]byte("70000")), &re))
+	assert.False(t, errors.As(ns.UnmarshalText([]byte("70000")), &de))
+}
+
+func TestLenientDecoding(t *testing.T) {
+	LenientDecoding = true
+	defer func() { LenientDecoding = false }()
+
+	s := NewString("a")
+	assert.NoError(t, json.Unmarshal([]byte(`123`), &s))
+	assert.False(t, s.Valid)
+
+	b := NewBool(true)
+	assert.NoError(t, json.Unmarshal([]byte(`"maybe"`), &b))
+	assert.False(t, b.Valid)
+
+	var v struct {
+		Time Time `xml:"time"`
+	}
+	assert.NoError(t, xml.Unmarshal([]byte(`<test><time>yesterday</time></test>`), &v))
+	assert.False(t, v.Time.Valid)
+
+	d := NewDuration(1)
+	assert.NoError(t, d.UnmarshalText([]byte("soon")))
+	assert.False(t, d.Valid)
+}
+
+func TestSetLenient(t *testing.T) {
+	SetLenient[Int](true)
+	defer SetLenient[Int](false)
+
+	i := NewInt(1)
+	assert.NoError(t, json.Unmarshal([]byte(`"abc"`), &i))
+	assert.False(t, i.Valid)
+
+	i64 := NewInt64(1)
+	assert.Error(t, json.Unmarshal([]byte(`"abc"`), &i64))
+
+	SetLenient[Int](false)
+	assert.Error(t, json.Unmarshal([]byte(`"abc"`), &i))
+}
@@ -0,0 +1,414 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationStyle selects the text representation of a Duration.
+type DurationStyle int
+
+const (
+	// ISO8601Duration renders durations as ISO 8601, i.e. "PT1H30M".
+	ISO8601Duration DurationStyle = iota
+	// GoDuration renders durations like time.Duration.String, i.e. "1h30m0s".
+	GoDuration
+)
+
+// DurationTextStyle is the style Duration uses for JSON, text
+// and XML. Decoding accepts both styles regardless.
+var DurationTextStyle = ISO8601Duration
+
+// Duration adds an implementation for time.Duration
+// that supports proper JSON encoding/decoding.
+type Duration struct {
+	Duration time.Duration
+	Valid    bool // Valid is true if Duration is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the duration is not valid, otherwise it returns the duration value.
+func (ns Duration) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.Duration
+}
+
+// NewDuration returns a new, properly instantiated
+// Duration object.
+func NewDuration(d time.Duration) Duration {
+	return Duration{Duration: d, Valid: true}
+}
+
+// String returns the duration in DurationTextStyle,
+// or "null" if it is not valid.
+func (ns Duration) String() string {
+	if !ns.Valid {
+		return "null"
+	}
+	if DurationTextStyle == GoDuration {
+		return ns.Duration.String()
+	}
+	return formatISO8601Duration(ns.Duration)
+}
+
+// Scan implements the Scanner interface. It accepts int64
+// nanoseconds as well as strings and []byte holding a
+// Postgres interval ("1 day 02:03:04"), an ISO 8601
+// duration or a Go duration.
+func (ns *Duration) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		ns.Duration, ns.Valid = 0, false
+		return nil
+	case int64:
+		ns.Duration, ns.Valid = time.Duration(v), true
+		return nil
+	case string:
+		return ns.parse(v)
+	case []byte:
+		return ns.parse(string(v))
+	}
+	return fmt.Errorf("nulls: cannot scan %T into Duration", value)
+}
+
+// Value implements the driver Valuer interface. The duration
+// is passed as ISO 8601 text, which Postgres accepts for
+// INTERVAL columns.
+func (ns Duration) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return formatISO8601Duration(ns.Duration), nil
+}
+
+// MarshalJSON marshals the duration as a string
+// in DurationTextStyle.
+func (ns Duration) MarshalJSON() ([]byte, error) {
+	if ns.Valid {
+		return json.Marshal(ns.String())
+	}
+	return json.Marshal(nil)
+}
+
+// UnmarshalJSON will unmarshal a duration string, or
+// a number of nanoseconds, into the duration.
+func (ns *Duration) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	txt := string(text)
+	if txt == "null" {
+		return nil
+	}
+
+	if i, err := strconv.ParseInt(txt, 10, 64); err == nil {
+		ns.Duration, ns.Valid = time.Duration(i), true
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(text, &s); err != nil {
+		return decoded(ns, &DecodeError{Type: "Duration", Text: txt, Err: err})
+	}
+	return decoded(ns, ns.parse(s))
+}
+
+// MarshalText marshals the duration in DurationTextStyle.
+func (ns Duration) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	return []byte(ns.String()), nil
+}
+
+// UnmarshalText will unmarshal text value into
+// the proper representation of that value.
+func (ns *Duration) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	return decoded(ns, ns.parse(t))
+}
+
+func (ns Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if ns.Valid {
+		return e.EncodeElement(ns.String(), start)
+	}
+	return nil
+}
+
+func (ns *Duration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(data))
+}
+
+func (ns Duration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if ns.Valid {
+		return xml.Attr{
+			Name:  name,
+			Value: ns.String(),
+		}, nil
+	}
+	return xml.Attr{}, nil
+}
+
+func (ns *Duration) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(attr.Value))
+}
+
+// parse sets the duration from s, which can be ISO 8601,
+// Go or Postgres interval syntax.
+func (ns *Duration) parse(s string) error {
+	d, isoErr := parseISO8601Duration(s)
+	err := isoErr
+	if err != nil {
+		d, err = time.ParseDuration(s)
+	}
+	if err != nil {
+		d, err = parseIntervalDuration(s)
+	}
+	if err != nil {
+		// report the ISO 8601 error for input that looks like one
+		if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+			err = isoErr
+		}
+		return &DecodeError{Type: "Duration", Text: s, Err: err}
+	}
+	ns.Duration, ns.Valid = d, true
+	return nil
+}
+
+// formatISO8601Duration formats d as an ISO 8601 duration
+// using hours, minutes and seconds only, i.e. "PT26H3M4.5S".
+func formatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+	}
+	b.WriteString("PT")
+
+	// work on the absolute value in uint64, -d overflows for math.MinInt64
+	u := uint64(d)
+	if d < 0 {
+		u = -u
+	}
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10) + "H")
+	}
+	u %= uint64(time.Hour)
+	if m := u / uint64(time.Minute); m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10) + "M")
+	}
+	u %= uint64(time.Minute)
+	if u > 0 {
+		s := strconv.FormatUint(u/uint64(time.Second), 10)
+		if frac := u % uint64(time.Second); frac > 0 {
+			s += strings.TrimRight(fmt.Sprintf(".%09d", frac), "0")
+		}
+		b.WriteString(s + "S")
+	}
+	return b.String()
+}
+
+// parseISO8601Duration parses an ISO 8601 duration with
+// week, day, hour, minute and second designators. Days are
+// taken to be 24 hours; years and months are rejected as
+// they do not have a fixed length.
+func parseISO8601Duration(s string) (time.Duration, error) {
+	orig := s
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg, s = true, s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) == 1 {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", orig)
+	}
+	s = s[1:]
+
+	// the magnitude is summed up in uint64 so that
+	// math.MinInt64 can be parsed as well
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+	var sum uint64
+	timePart := false
+	// designators must come in this order, each at most once
+	const order = "WDHMS"
+	last := -1
+	for s != "" {
+		if s[0] == 'T' {
+			if timePart || len(s) == 1 {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q", orig)
+			}
+			timePart, s = true, s[1:]
+			continue
+		}
+
+		i := strings.IndexAny(s, "WDHMS")
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", orig)
+		}
+		n, err := strconv.ParseFloat(strings.Replace(s[:i], ",", ".", 1), 64)
+		if err != nil || n < 0 || math.IsInf(n, 0) || math.IsNaN(n) {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", orig)
+		}
+
+		var unit time.Duration
+		switch {
+		case !timePart && s[i] == 'W':
+			unit = 7 * 24 * time.Hour
+		case !timePart && s[i] == 'D':
+			unit = 24 * time.Hour
+		case timePart && s[i] == 'H':
+			unit = time.Hour
+		case timePart && s[i] == 'M':
+			unit = time.Minute
+		case timePart && s[i] == 'S':
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", orig)
+		}
+		k := strings.IndexByte(order, s[i])
+		if k <= last {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", orig)
+		}
+		last = k
+
+		term := math.Round(n * float64(unit))
+		if term > float64(limit) || uint64(term) > limit-sum {
+			return 0, fmt.Errorf("ISO 8601 duration %q is out of range", orig)
+		}
+		sum += uint64(term)
+		s = s[i+1:]
+	}
+
+	d := time.Duration(sum)
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// parseIntervalDuration parses the Postgres interval output
+// format, i.e. "1 day 02:03:04" or "-2 days +01:00:00.5".
+// Days are taken to be 24 hours; years and months are
+// rejected as they do not have a fixed length.
+func parseIntervalDuration(s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+
+	var d time.Duration
+	var ok bool
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if strings.Contains(f, ":") {
+			t, err := parseIntervalClock(f)
+			if err != nil {
+				return 0, fmt.Errorf("invalid interval %q", s)
+			}
+			if d, ok = addDuration(d, t); !ok {
+				return 0, fmt.Errorf("interval %q is out of range", s)
+			}
+			continue
+		}
+
+		if i+1 == len(fields) {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+		n, err := strconv.ParseInt(f, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+		i++
+		switch fields[i] {
+		case "day", "days":
+			const day = 24 * time.Hour
+			if n > math.MaxInt64/int64(day) || n < math.MinInt64/int64(day) {
+				return 0, fmt.Errorf("interval %q is out of range", s)
+			}
+			if d, ok = addDuration(d, time.Duration(n)*day); !ok {
+				return 0, fmt.Errorf("interval %q is out of range", s)
+			}
+		default:
+			return 0, fmt.Errorf("invalid interval %q: unsupported unit %q", s, fields[i])
+		}
+	}
+	return d, nil
+}
+
+// addDuration returns a + b. ok is false if the sum
+// overflows time.Duration.
+func addDuration(a, b time.Duration) (sum time.Duration, ok bool) {
+	sum = a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, false
+	}
+	return sum, true
+}
+
+// parseIntervalClock parses the [+-]HH:MM:SS[.ffffff] part
+// of a Postgres interval.
+func parseIntervalClock(s string) (time.Duration, error) {
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg, s = true, s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid interval clock %q", s)
+	}
+	h, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if h >= uint64(math.MaxInt64/int64(time.Hour)) {
+		return 0, fmt.Errorf("interval clock %q is out of range", s)
+	}
+	m, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || m > 59 {
+		return 0, fmt.Errorf("invalid interval clock %q", s)
+	}
+	sec, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil || sec < 0 || sec >= 60 {
+		return 0, fmt.Errorf("invalid interval clock %q", s)
+	}
+
+	d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(math.Round(sec*float64(time.Second)))
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
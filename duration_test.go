@@ -0,0 +1,135 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration_Scan(t *testing.T) {
+	for value, want := range map[interface{}]time.Duration{
+		"1 day 02:03:04":      26*time.Hour + 3*time.Minute + 4*time.Second,
+		"-2 days +01:00:00.5": -47*time.Hour + 500*time.Millisecond,
+		"00:00:00":            0,
+		"-00:01:00":           -time.Minute,
+		"3 days":              72 * time.Hour,
+		"P1DT2H":              26 * time.Hour,
+		"PT1H30M":             90 * time.Minute,
+		"1h30m":               90 * time.Minute,
+		int64(1500):           1500,
+	} {
+		var ns Duration
+		assert.NoError(t, ns.Scan(value), "%v", value)
+		assert.Equal(t, NewDuration(want), ns, "%v", value)
+	}
+
+	var ns Duration
+	assert.NoError(t, ns.Scan([]byte("01:00:00")))
+	assert.Equal(t, NewDuration(time.Hour), ns)
+
+	assert.Error(t, ns.Scan("1 mon"))
+	assert.Error(t, ns.Scan("P1M"))
+	assert.Error(t, ns.Scan("soon"))
+	assert.NoError(t, ns.Scan(nil))
+	assert.Equal(t, false, ns.Valid)
+}
+
+func TestDuration_Value(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                                    "PT0S",
+		90 * time.Minute:                     "PT1H30M",
+		26*time.Hour + 1500*time.Millisecond: "PT26H1.5S",
+		-time.Second:                         "-PT1S",
+	} {
+		v, err := NewDuration(d).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, want, v)
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	defer func(style DurationStyle) { DurationTextStyle = style }(DurationTextStyle)
+
+	val := NewDuration(90 * time.Minute)
+	data, err := json.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `"PT1H30M"`, string(data))
+
+	DurationTextStyle = GoDuration
+	data, err = json.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `"1h30m0s"`, string(data))
+
+	for _, text := range []string{`"PT1H30M"`, `"1h30m0s"`, `5400000000000`} {
+		var ns Duration
+		assert.NoError(t, json.Unmarshal([]byte(text), &ns))
+		assert.Equal(t, val, ns)
+	}
+
+	var ns Duration
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &ns))
+	assert.Equal(t, false, ns.Valid)
+	assert.Error(t, json.Unmarshal([]byte(`"later"`), &ns))
+}
+
+func TestDuration_XML(t *testing.T) {
+	type test struct {
+		Elem Duration `xml:"elem"`
+		Attr Duration `xml:"attr,attr"`
+	}
+
+	val := test{Elem: NewDuration(time.Minute), Attr: NewDuration(time.Second)}
+	data, err := xml.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `<test attr="PT1S"><elem>PT1M</elem></test>`, string(data))
+
+	var out test
+	assert.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, val, out)
+}
+
+func TestDuration_Overflow(t *testing.T) {
+	for _, text := range []string{
+		"P100000000W",
+		"P200000D",
+		"PT3000000H",
+		"P100000DT1000000H",
+		"-P200000D",
+		"200000 days 00:00:00",
+		"-200000 days",
+		"100000 days 1000000:00:00",
+		"3000000:00:00",
+	} {
+		var ns Duration
+		assert.Error(t, ns.UnmarshalText([]byte(text)), text)
+		assert.False(t, ns.Valid, text)
+		assert.Error(t, ns.Scan(text), text)
+	}
+
+	for _, d := range []time.Duration{math.MaxInt64, math.MinInt64} {
+		text, err := NewDuration(d).MarshalText()
+		assert.NoError(t, err)
+
+		var ns Duration
+		assert.NoError(t, ns.UnmarshalText(text), string(text))
+		assert.Equal(t, NewDuration(d), ns)
+	}
+
+	var ns Duration
+	assert.NoError(t, ns.Scan("-100000 days 1000000:00:00"))
+	assert.Equal(t, -100000*24*time.Hour+1000000*time.Hour, ns.Duration)
+}
+
+func TestDuration_Order(t *testing.T) {
+	var ns Duration
+	assert.NoError(t, ns.UnmarshalText([]byte("P1W2DT3H4M5S")))
+	assert.Equal(t, NewDuration(9*24*time.Hour+3*time.Hour+4*time.Minute+5*time.Second), ns)
+
+	for _, text := range []string{"PT1S1H", "PT1H1H", "P1D1W", "P1D1D", "PT1M1H", "PT1S1M"} {
+		assert.Error(t, ns.UnmarshalText([]byte(text)), text)
+	}
+}
@@ -0,0 +1,43 @@
+package nulls
+
+import "fmt"
+
+// RangeError is returned when a number being scanned or decoded
+// does not fit into the type of a nullable, i.e. -1 for UInt32
+// or 300 for Int8.
+type RangeError struct {
+	Value string // the offending number as text
+	Type  string // the Go type it does not fit into, i.e. "uint32"
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("nulls: %s is out of range for %s", e.Value, e.Type)
+}
+
+// DecodeError is returned when JSON, text or XML input cannot
+// be decoded into a nullable type.
+type DecodeError struct {
+	Type string // the nullable type, i.e. "Int64"
+	Text string // the offending input
+	Err  error  // the underlying error, if any
+}
+
+func (e *DecodeError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("nulls: cannot decode %s into %s", excerpt(e.Text), e.Type)
+	}
+	return fmt.Sprintf("nulls: cannot decode %s into %s: %v", excerpt(e.Text), e.Type, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// excerpt quotes s for an error message, shortening long input.
+func excerpt(s string) string {
+	const maxLen = 64
+	if len(s) > maxLen {
+		return fmt.Sprintf("%q...", s[:maxLen])
+	}
+	return fmt.Sprintf("%q", s)
+}
@@ -0,0 +1,69 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RangeError_Scan(t *testing.T) {
+	r := require.New(t)
+
+	var re *RangeError
+
+	u := UInt32{}
+	r.ErrorAs(u.Scan(int64(5000000000)), &re)
+	r.Equal("uint32", re.Type)
+	r.ErrorAs(u.Scan(int64(-1)), &re)
+	r.Equal("-1", re.Value)
+	r.False(u.Valid)
+
+	i := Int32{}
+	r.ErrorAs(i.Scan("2147483648"), &re)
+	r.ErrorAs(i.Scan(float64(-3e9)), &re)
+	r.Equal("-3e+09", re.Value)
+	r.NoError(i.Scan(float64(1e3)))
+	r.Equal(NewInt32(1000), i)
+	r.NoError(i.Scan(int64(-2147483648)))
+	r.Equal(NewInt32(-2147483648), i)
+
+	f := Float32{}
+	r.ErrorAs(f.Scan(float64(1e300)), &re)
+	r.Equal("float32", re.Type)
+	r.EqualError(re, "nulls: 1e+300 is out of range for float32")
+	r.NoError(f.Scan(float64(3.25)))
+	r.Equal(NewFloat32(3.25), f)
+
+	s := Int16{}
+	r.ErrorAs(s.Scan([]byte("40000")), &re)
+}
+
+func Test_RangeError_Decode(t *testing.T) {
+	r := require.New(t)
+
+	var re *RangeError
+
+	u := UInt32{}
+	r.ErrorAs(json.Unmarshal([]byte("-1"), &u), &re)
+	r.ErrorAs(u.UnmarshalText([]byte("4294967296")), &re)
+	r.False(u.Valid)
+
+	f := Float32{}
+	r.ErrorAs(json.Unmarshal([]byte("1e39"), &f), &re)
+
+	type test struct {
+		Elem UInt8 `xml:"elem"`
+		Attr Int8  `xml:"attr,attr"`
+	}
+	val := test{}
+	r.ErrorAs(xml.Unmarshal([]byte(`<test><elem>-1</elem></test>`), &val), &re)
+	r.ErrorAs(xml.Unmarshal([]byte(`<test attr="128"></test>`), &val), &re)
+	r.Equal("int8", re.Type)
+
+	err := u.UnmarshalText([]byte("ten"))
+	r.Error(err)
+	r.False(errors.As(err, &re))
+}
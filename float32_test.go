@@ -1,8 +1,10 @@
 package nulls
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"testing"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -59,7 +61,7 @@ func TestFloat32Valid_UnmarshalXML(t *testing.T) {
 
 	var val test
 
-	err := xml.Unmarshal([]byte(xml.Header+`<test><val>3.22</wval></test>`), &val)
+	err := xml.Unmarshal([]byte(xml.Header+`<test><val>3.22</val></test>`), &val)
 	assert.NoError(t, err)
 
 	assert.Equal(t, true, val.Val.Valid)
@@ -125,3 +127,54 @@ func TestFloat32Valid_UnmarshalXMLAttr(t *testing.T) {
 	assert.Equal(t, true, val.Val.Valid)
 	assert.Equal(t, float32(3.22), val.Val.Float32)
 }
+
+func TestFloat32_ShortestFormat(t *testing.T) {
+	type test struct {
+		Attr Float32 `xml:"attr,attr" json:"attr"`
+		Elem Float32 `xml:"elem" json:"elem"`
+	}
+
+	for f, want := range map[float32]string{
+		3.22:     "3.22",
+		0.1:      "0.1",
+		16777216: "16777216",
+		1e-7:     "1e-7",
+		3e21:     "3e+21",
+	} {
+		val := test{Attr: NewFloat32(f), Elem: NewFloat32(f)}
+
+		data, err := json.Marshal(val)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"attr":`+want+`,"elem":`+want+`}`, string(data))
+
+		data, err = xml.Marshal(val)
+		assert.NoError(t, err)
+		assert.Equal(t, `<test attr="`+want+`"><elem>`+want+`</elem></test>`, string(data))
+
+		text, err := NewFloat32(f).MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, want, string(text))
+	}
+}
+
+func TestFloat32_Format(t *testing.T) {
+	defer func() { Float32Format = FloatFormat{} }()
+
+	Float32Format = FloatFormat{Style: FixedFloat, Precision: 2}
+	data, err := json.Marshal(NewFloat32(3.2))
+	assert.NoError(t, err)
+	assert.Equal(t, "3.20", string(data))
+
+	Float32Format = FloatFormat{Style: SignificantFloat, Precision: 3}
+	data, err = xml.Marshal(struct {
+		XMLName xml.Name `xml:"test"`
+		Val     Float32  `xml:"val,attr"`
+	}{Val: NewFloat32(1234.5)})
+	assert.NoError(t, err)
+	assert.Equal(t, `<test val="1.23e+03"></test>`, string(data))
+
+	// Float64 keeps its own format
+	data, err = json.Marshal(NewFloat64(1234.5))
+	assert.NoError(t, err)
+	assert.Equal(t, "1234.5", string(data))
+}
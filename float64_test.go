@@ -0,0 +1,148 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type floats struct {
+	XMLName xml.Name `xml:"floats" json:"-"`
+	A       Float64  `xml:"a,attr" json:"a"`
+	B       Float64  `xml:"b" json:"b"`
+	C       Float32  `xml:"c" json:"c"`
+}
+
+func nonFiniteFloats() floats {
+	return floats{
+		A: NewFloat64(math.NaN()),
+		B: NewFloat64(math.Inf(1)),
+		C: NewFloat32(float32(math.Inf(-1))),
+	}
+}
+
+func TestFloat_NonFiniteError(t *testing.T) {
+	_, err := json.Marshal(nonFiniteFloats())
+	assert.Error(t, err)
+
+	_, err = xml.Marshal(nonFiniteFloats())
+	assert.Error(t, err)
+
+	_, err = NewFloat64(math.NaN()).MarshalText()
+	assert.Error(t, err)
+
+	data, err := json.Marshal(floats{A: NewFloat64(1.5)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1.5,"b":null,"c":null}`, string(data))
+}
+
+func TestFloat_NonFiniteNull(t *testing.T) {
+	FloatNonFinite = NonFiniteNull
+	defer func() { FloatNonFinite = NonFiniteError }()
+
+	data, err := json.Marshal(nonFiniteFloats())
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":null,"b":null,"c":null}`, string(data))
+
+	data, err = xml.Marshal(nonFiniteFloats())
+	require.NoError(t, err)
+	assert.Equal(t, `<floats></floats>`, string(data))
+
+	text, err := NewFloat32(float32(math.NaN())).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "", string(text))
+}
+
+func TestFloat_NonFiniteString(t *testing.T) {
+	FloatNonFinite = NonFiniteString
+	defer func() { FloatNonFinite = NonFiniteError }()
+
+	data, err := json.Marshal(nonFiniteFloats())
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":"NaN","b":"Infinity","c":"-Infinity"}`, string(data))
+
+	var fj floats
+	require.NoError(t, json.Unmarshal(data, &fj))
+	assert.True(t, math.IsNaN(fj.A.Float64))
+	assert.True(t, math.IsInf(fj.B.Float64, 1))
+	assert.True(t, math.IsInf(float64(fj.C.Float32), -1))
+
+	data, err = xml.Marshal(nonFiniteFloats())
+	require.NoError(t, err)
+	assert.Equal(t, `<floats a="NaN"><b>Infinity</b><c>-Infinity</c></floats>`, string(data))
+
+	var fx floats
+	require.NoError(t, xml.Unmarshal(data, &fx))
+	assert.True(t, math.IsNaN(fx.A.Float64))
+	assert.True(t, math.IsInf(fx.B.Float64, 1))
+	assert.True(t, math.IsInf(float64(fx.C.Float32), -1))
+
+	text, err := NewFloat64(math.Inf(-1)).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "-Infinity", string(text))
+
+	var ns Float64
+	require.NoError(t, ns.UnmarshalText(text))
+	assert.True(t, math.IsInf(ns.Float64, -1))
+
+	assert.Error(t, json.Unmarshal([]byte(`"1.5"`), &ns))
+}
+
+func TestFloat_ScanNaNAsNull(t *testing.T) {
+	var ns Float64
+	require.NoError(t, ns.Scan("NaN"))
+	assert.True(t, ns.Valid)
+	assert.True(t, math.IsNaN(ns.Float64))
+
+	ScanNaNAsNull = true
+	defer func() { ScanNaNAsNull = false }()
+
+	for _, value := range []interface{}{"NaN", []byte("NaN"), math.NaN()} {
+		ns = NewFloat64(1)
+		require.NoError(t, ns.Scan(value))
+		assert.False(t, ns.Valid, "%v", value)
+	}
+
+	var f32 Float32
+	require.NoError(t, f32.Scan(math.NaN()))
+	assert.False(t, f32.Valid)
+
+	require.NoError(t, ns.Scan(math.Inf(1)))
+	assert.True(t, ns.Valid)
+}
+
+func TestFloat64_Format(t *testing.T) {
+	for f, want := range map[float64]string{
+		3.22:                 "3.22",
+		0.30000000000000004:  "0.30000000000000004",
+		1e-7:                 "1e-7",
+		123456789012345678.0: "123456789012345680",
+		1e21:                 "1e+21",
+	} {
+		data, err := json.Marshal(NewFloat64(f))
+		require.NoError(t, err)
+		assert.Equal(t, want, string(data))
+
+		data, err = xml.Marshal(struct {
+			XMLName xml.Name `xml:"v"`
+			V       Float64  `xml:"v"`
+		}{V: NewFloat64(f)})
+		require.NoError(t, err)
+		assert.Equal(t, "<v><v>"+want+"</v></v>", string(data))
+	}
+
+	Float64Format = FloatFormat{Style: FixedFloat, Precision: 1}
+	defer func() { Float64Format = FloatFormat{} }()
+
+	text, err := NewFloat64(2.25).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2.2", string(text))
+
+	data, err := json.Marshal(NewNull(2.0))
+	require.NoError(t, err)
+	assert.Equal(t, "2.0", string(data))
+}
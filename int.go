@@ -1,11 +1,8 @@
 package nulls
 
 import (
-	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
 	"encoding/xml"
-	"strconv"
 )
 
 // Int adds an implementation for int
@@ -18,10 +15,7 @@ type Int struct {
 // Interface implements the nullable interface. It returns nil if
 // the int is not valid, otherwise it returns the int value.
 func (ns Int) Interface() interface{} {
-	if !ns.Valid {
-		return nil
-	}
-	return ns.Int
+	return ns.null().Interface()
 }
 
 // NewInt returns a new, properly instantiated
@@ -30,111 +24,65 @@ func NewInt(i int) Int {
 	return Int{Int: i, Valid: true}
 }
 
+func (ns Int) null() Null[int] {
+	return Null[int]{V: ns.Int, Valid: ns.Valid}
+}
+
 // Scan implements the Scanner interface.
 func (ns *Int) Scan(value interface{}) error {
-	n := sql.NullInt64{Int64: int64(ns.Int)}
+	n := ns.null()
 	err := n.Scan(value)
-	ns.Int, ns.Valid = int(n.Int64), n.Valid
+	ns.Int, ns.Valid = n.V, n.Valid
 	return err
 }
 
 // Value implements the driver Valuer interface.
 func (ns Int) Value() (driver.Value, error) {
-	if !ns.Valid {
-		return nil, nil
-	}
-	return int64(ns.Int), nil
+	return ns.null().Value()
 }
 
 // MarshalJSON marshals the underlying value to a
 // proper JSON representation.
 func (ns Int) MarshalJSON() ([]byte, error) {
-	if ns.Valid {
-		return json.Marshal(ns.Int)
-	}
-	return json.Marshal(nil)
+	return ns.null().MarshalJSON()
 }
 
 // UnmarshalJSON will unmarshal a JSON value into
 // the propert representation of that value.
 func (ns *Int) UnmarshalJSON(text []byte) error {
-	if i, err := strconv.ParseInt(string(text), 10, strconv.IntSize); err == nil {
-		ns.Valid = true
-		ns.Int = int(i)
-	}
-	return nil
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.Int, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 // UnmarshalText will unmarshal text value into
 // the propert representation of that value.
 func (ns *Int) UnmarshalText(text []byte) error {
-	return ns.UnmarshalJSON(text)
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.Int, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 func (ns Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	if ns.Valid {
-		return e.EncodeElement(ns.Int, start)
-	}
-	return nil
+	return ns.null().MarshalXML(e, start)
 }
 
 func (ns *Int) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	var data string
-	err := d.DecodeElement(&data, &start)
-
-	if err != nil {
-		return err
-	}
-	if data == "" {
-		return nil
-	}
-
-	if data == "null" {
-		return nil
-	}
-
-	val, err := strconv.ParseInt(data, 10, strconv.IntSize)
-
-	if err != nil {
-		return err
-	}
-
-	ns.Valid = true
-	ns.Int = int(val)
-
-	return nil
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.Int, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 func (ns Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
-	if ns.Valid {
-		var value string
-		value = strconv.FormatInt(int64(ns.Int), 10)
-
-		return xml.Attr{
-			Name:  name,
-			Value: value,
-		}, nil
-	}
-	return xml.Attr{}, nil
+	return ns.null().MarshalXMLAttr(name)
 }
 
 func (ns *Int) UnmarshalXMLAttr(attr xml.Attr) error {
-	if attr.Value == "" {
-		return nil
-	}
-
-	if attr.Value == "null" {
-		return nil
-	}
-
-	val, err := strconv.ParseInt(attr.Value, 10, strconv.IntSize)
-
-	if err != nil {
-		return err
-	}
-
-	ns.Valid = true
-	ns.Int = int(val)
-
-	return nil
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.Int, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
@@ -0,0 +1,88 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// Int16 adds an implementation for int16
+// that supports proper JSON encoding/decoding.
+type Int16 struct {
+	Int16 int16
+	Valid bool // Valid is true if Int16 is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the int16 is not valid, otherwise it returns the int16 value.
+func (ns Int16) Interface() interface{} {
+	return ns.null().Interface()
+}
+
+// NewInt16 returns a new, properly instantiated
+// Int16 object.
+func NewInt16(i int16) Int16 {
+	return Int16{Int16: i, Valid: true}
+}
+
+func (ns Int16) null() Null[int16] {
+	return Null[int16]{V: ns.Int16, Valid: ns.Valid}
+}
+
+// Scan implements the Scanner interface.
+func (ns *Int16) Scan(value interface{}) error {
+	n := ns.null()
+	err := n.Scan(value)
+	ns.Int16, ns.Valid = n.V, n.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (ns Int16) Value() (driver.Value, error) {
+	return ns.null().Value()
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns Int16) MarshalJSON() ([]byte, error) {
+	return ns.null().MarshalJSON()
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the propert representation of that value.
+func (ns *Int16) UnmarshalJSON(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.Int16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+// UnmarshalText will unmarshal text value into
+// the propert representation of that value.
+func (ns *Int16) UnmarshalText(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.Int16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns Int16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ns.null().MarshalXML(e, start)
+}
+
+func (ns *Int16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.Int16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns Int16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return ns.null().MarshalXMLAttr(name)
+}
+
+func (ns *Int16) UnmarshalXMLAttr(attr xml.Attr) error {
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.Int16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
@@ -3,9 +3,7 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
 	"encoding/xml"
-	"strconv"
 )
 
 // Int64 replaces sql.Int64 with an implementation
@@ -15,10 +13,7 @@ type Int64 sql.NullInt64
 // Interface implements the nullable interface. It returns nil if
 // the int64 is not valid, otherwise it returns the int64 value.
 func (ns Int64) Interface() interface{} {
-	if !ns.Valid {
-		return nil
-	}
-	return ns.Int64
+	return ns.null().Interface()
 }
 
 // NewInt64 returns a new, properly instantiated
@@ -27,119 +22,65 @@ func NewInt64(i int64) Int64 {
 	return Int64{Int64: i, Valid: true}
 }
 
+func (ns Int64) null() Null[int64] {
+	return Null[int64]{V: ns.Int64, Valid: ns.Valid}
+}
+
 // Scan implements the Scanner interface.
 func (ns *Int64) Scan(value interface{}) error {
-	n := sql.NullInt64{Int64: ns.Int64}
+	n := ns.null()
 	err := n.Scan(value)
-	ns.Int64, ns.Valid = n.Int64, n.Valid
+	ns.Int64, ns.Valid = n.V, n.Valid
 	return err
 }
 
 // Value implements the driver Valuer interface.
 func (ns Int64) Value() (driver.Value, error) {
-	if !ns.Valid {
-		return nil, nil
-	}
-	return ns.Int64, nil
+	return ns.null().Value()
 }
 
 // MarshalJSON marshals the underlying value to a
 // proper JSON representation.
 func (ns Int64) MarshalJSON() ([]byte, error) {
-	if ns.Valid {
-		return json.Marshal(ns.Int64)
-	}
-	return json.Marshal(nil)
+	return ns.null().MarshalJSON()
 }
 
 // UnmarshalJSON will unmarshal a JSON value into
 // the propert representation of that value.
 func (ns *Int64) UnmarshalJSON(text []byte) error {
-	t := string(text)
-	ns.Valid = true
-	if t == "null" {
-		ns.Valid = false
-		return nil
-	}
-	i, err := strconv.ParseInt(t, 10, 64)
-	if err != nil {
-		ns.Valid = false
-		return err
-	}
-	ns.Int64 = i
-	return nil
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.Int64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 // UnmarshalText will unmarshal text value into
 // the propert representation of that value.
 func (ns *Int64) UnmarshalText(text []byte) error {
-	return ns.UnmarshalJSON(text)
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.Int64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 func (ns Int64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	if ns.Valid {
-		return e.EncodeElement(ns.Int64, start)
-	}
-	return nil
+	return ns.null().MarshalXML(e, start)
 }
 
 func (ns *Int64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	var data string
-	err := d.DecodeElement(&data, &start)
-
-	if err != nil {
-		return err
-	}
-	if data == "" {
-		return nil
-	}
-
-	if data == "null" {
-		return nil
-	}
-
-	val, err := strconv.ParseInt(data, 10, 64)
-
-	if err != nil {
-		return err
-	}
-
-	ns.Valid = true
-	ns.Int64 = val
-
-	return nil
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.Int64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 func (ns Int64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
-	if ns.Valid {
-		var value string
-		value = strconv.FormatInt(int64(ns.Int64), 10)
-
-		return xml.Attr{
-			Name:  name,
-			Value: value,
-		}, nil
-	}
-	return xml.Attr{}, nil
+	return ns.null().MarshalXMLAttr(name)
 }
 
 func (ns *Int64) UnmarshalXMLAttr(attr xml.Attr) error {
-	if attr.Value == "" {
-		return nil
-	}
-
-	if attr.Value == "null" {
-		return nil
-	}
-
-	val, err := strconv.ParseInt(attr.Value, 10, 64)
-
-	if err != nil {
-		return err
-	}
-
-	ns.Valid = true
-	ns.Int64 = val
-
-	return nil
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.Int64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
@@ -0,0 +1,87 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+)
+
+// Int64String is a Int64 that is encoded as a JSON string, i.e.
+// "9007199254740993", so that JavaScript clients do not lose
+// precision above 2^53. Decoding accepts strings and numbers.
+// It works like the ",string" option of encoding/json, which
+// does not apply to types with their own marshalers.
+type Int64String Int64
+
+// Interface implements the nullable interface. It returns nil if
+// the int64 is not valid, otherwise it returns the int64 value.
+func (ns Int64String) Interface() interface{} {
+	return Int64(ns).Interface()
+}
+
+// NewInt64String returns a new, properly instantiated
+// Int64String object.
+func NewInt64String(i int64) Int64String {
+	return Int64String{Int64: i, Valid: true}
+}
+
+// Scan implements the Scanner interface.
+func (ns *Int64String) Scan(value interface{}) error {
+	return (*Int64)(ns).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns Int64String) Value() (driver.Value, error) {
+	return Int64(ns).Value()
+}
+
+// MarshalJSON marshals the underlying value to
+// a JSON string.
+func (ns Int64String) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(strconv.FormatInt(ns.Int64, 10))
+}
+
+// UnmarshalJSON will unmarshal a JSON string or
+// number into the int64.
+func (ns *Int64String) UnmarshalJSON(text []byte) error {
+	if len(text) > 1 && text[0] == '"' {
+		var s string
+		if err := json.Unmarshal(text, &s); err != nil {
+			ns.Valid = false
+			return decoded(ns, &DecodeError{Type: "Int64String", Text: string(text), Err: err})
+		}
+		// a quoted null or empty string is not a number
+		if s == "" || s == "null" {
+			ns.Valid = false
+			return decoded(ns, &DecodeError{Type: "Int64String", Text: string(text)})
+		}
+		text = []byte(s)
+	}
+	return decoded(ns, (*Int64)(ns).UnmarshalJSON(text))
+}
+
+// UnmarshalText will unmarshal text value into
+// the proper representation of that value.
+func (ns *Int64String) UnmarshalText(text []byte) error {
+	return decoded(ns, (*Int64)(ns).UnmarshalText(text))
+}
+
+func (ns Int64String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return Int64(ns).MarshalXML(e, start)
+}
+
+func (ns *Int64String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return decoded(ns, (*Int64)(ns).UnmarshalXML(d, start))
+}
+
+func (ns Int64String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return Int64(ns).MarshalXMLAttr(name)
+}
+
+func (ns *Int64String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return decoded(ns, (*Int64)(ns).UnmarshalXMLAttr(attr))
+}
@@ -0,0 +1,98 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt64String_MarshalJSON(t *testing.T) {
+	type test struct {
+		ID    Int64String  `json:"id"`
+		Count UInt64String `json:"count"`
+	}
+
+	data, err := json.Marshal(test{
+		ID:    NewInt64String(9007199254740993),
+		Count: NewUInt64String(18446744073709551615),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"9007199254740993","count":"18446744073709551615"}`, string(data))
+
+	data, err = json.Marshal(test{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":null,"count":null}`, string(data))
+}
+
+func TestInt64String_UnmarshalJSON(t *testing.T) {
+	for text, want := range map[string]Int64String{
+		`"9007199254740993"`: NewInt64String(9007199254740993),
+		`-42`:                NewInt64String(-42),
+		`"-42"`:              NewInt64String(-42),
+		`null`:               {},
+	} {
+		var ns Int64String
+		assert.NoError(t, json.Unmarshal([]byte(text), &ns), text)
+		assert.Equal(t, want, ns, text)
+	}
+
+	var ns Int64String
+	var de *DecodeError
+	err := json.Unmarshal([]byte(`"abc"`), &ns)
+	require.True(t, errors.As(err, &de))
+	assert.Equal(t, "Int64String", de.Type)
+	assert.Error(t, json.Unmarshal([]byte(`"1.5"`), &ns))
+	assert.Error(t, json.Unmarshal([]byte(`""`), &ns))
+	err = json.Unmarshal([]byte(`"null"`), &ns)
+	require.True(t, errors.As(err, &de))
+	assert.Equal(t, `"null"`, de.Text)
+	assert.False(t, ns.Valid)
+
+	var u UInt64String
+	assert.NoError(t, json.Unmarshal([]byte(`"18446744073709551615"`), &u))
+	assert.Equal(t, NewUInt64String(18446744073709551615), u)
+	assert.NoError(t, json.Unmarshal([]byte(`7`), &u))
+	assert.Equal(t, NewUInt64String(7), u)
+
+	assert.ErrorAs(t, json.Unmarshal([]byte(`"null"`), &u), &de)
+	assert.Equal(t, "UInt64String", de.Type)
+	assert.Error(t, json.Unmarshal([]byte(`""`), &u))
+
+	var re *RangeError
+	assert.ErrorAs(t, json.Unmarshal([]byte(`"-1"`), &u), &re)
+}
+
+func TestInt64String_ScanValue(t *testing.T) {
+	var ns Int64String
+	require.NoError(t, ns.Scan(int64(42)))
+	assert.Equal(t, NewInt64String(42), ns)
+
+	v, err := ns.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+
+	n, err := New(Int64String{}).Parse(int64(5))
+	require.NoError(t, err)
+	assert.Equal(t, NewInt64String(5), n)
+	assert.Equal(t, NewInt64(5), New(int64(5)).Nullable)
+}
+
+func TestInt64String_XML(t *testing.T) {
+	type test struct {
+		ID Int64String `xml:"id,attr"`
+		N  Int64String `xml:"n"`
+	}
+
+	in := test{ID: NewInt64String(3), N: NewInt64String(4)}
+	data, err := xml.Marshal(in)
+	require.NoError(t, err)
+	assert.Equal(t, `<test id="3"><n>4</n></test>`, string(data))
+
+	var out test
+	require.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
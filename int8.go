@@ -0,0 +1,88 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// Int8 adds an implementation for int8
+// that supports proper JSON encoding/decoding.
+type Int8 struct {
+	Int8  int8
+	Valid bool // Valid is true if Int8 is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the int8 is not valid, otherwise it returns the int8 value.
+func (ns Int8) Interface() interface{} {
+	return ns.null().Interface()
+}
+
+// NewInt8 returns a new, properly instantiated
+// Int8 object.
+func NewInt8(i int8) Int8 {
+	return Int8{Int8: i, Valid: true}
+}
+
+func (ns Int8) null() Null[int8] {
+	return Null[int8]{V: ns.Int8, Valid: ns.Valid}
+}
+
+// Scan implements the Scanner interface.
+func (ns *Int8) Scan(value interface{}) error {
+	n := ns.null()
+	err := n.Scan(value)
+	ns.Int8, ns.Valid = n.V, n.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (ns Int8) Value() (driver.Value, error) {
+	return ns.null().Value()
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns Int8) MarshalJSON() ([]byte, error) {
+	return ns.null().MarshalJSON()
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the propert representation of that value.
+func (ns *Int8) UnmarshalJSON(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.Int8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+// UnmarshalText will unmarshal text value into
+// the propert representation of that value.
+func (ns *Int8) UnmarshalText(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.Int8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns Int8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ns.null().MarshalXML(e, start)
+}
+
+func (ns *Int8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.Int8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns Int8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return ns.null().MarshalXMLAttr(name)
+}
+
+func (ns *Int8) UnmarshalXMLAttr(attr xml.Attr) error {
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.Int8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
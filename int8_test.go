@@ -0,0 +1,46 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt8_Overflow(t *testing.T) {
+	var ns Int8
+	assert.Error(t, ns.Scan(int64(128)))
+	assert.Error(t, json.Unmarshal([]byte("-129"), &ns))
+	assert.Equal(t, false, ns.Valid)
+
+	assert.NoError(t, ns.Scan(int64(-128)))
+	assert.Equal(t, NewInt8(-128), ns)
+}
+
+func TestSmallIntegers_XML(t *testing.T) {
+	type test struct {
+		I8  Int8   `xml:"i8,attr"`
+		I16 Int16  `xml:"i16"`
+		U   UInt   `xml:"u"`
+		U8  UInt8  `xml:"u8,attr"`
+		U16 UInt16 `xml:"u16"`
+	}
+
+	val := test{
+		I8:  NewInt8(-8),
+		I16: NewInt16(-16),
+		U:   NewUInt(1),
+		U8:  NewUInt8(8),
+	}
+
+	data, err := xml.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `<test i8="-8" u8="8"><i16>-16</i16><u>1</u></test>`, string(data))
+
+	var out test
+	assert.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, val, out)
+
+	assert.Error(t, xml.Unmarshal([]byte(`<test u8="256"></test>`), &out))
+}
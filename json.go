@@ -0,0 +1,173 @@
+package nulls
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+// JSON adds an implementation for raw JSON documents, i.e.
+// JSON/JSONB columns, that supports proper JSON encoding/decoding.
+// An invalid JSON is SQL NULL, while a valid one may hold the
+// JSON null literal.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool // Valid is true if JSON is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the JSON is not valid, otherwise it returns the json.RawMessage.
+func (ns JSON) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.JSON
+}
+
+// NewJSON returns a new, properly instantiated
+// JSON object.
+func NewJSON(j json.RawMessage) JSON {
+	return JSON{JSON: j, Valid: true}
+}
+
+// IsJSONNull reports whether ns holds the JSON null literal,
+// as opposed to being SQL NULL.
+func (ns JSON) IsJSONNull() bool {
+	return ns.Valid && string(bytes.TrimSpace(ns.JSON)) == "null"
+}
+
+// Scan implements the Scanner interface.
+func (ns *JSON) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		ns.JSON, ns.Valid = nil, false
+		return nil
+	case string:
+		ns.JSON, ns.Valid = json.RawMessage(v), true
+		return nil
+	case []byte:
+		ns.JSON, ns.Valid = append(json.RawMessage{}, v...), true
+		return nil
+	}
+	return fmt.Errorf("nulls: cannot scan %T into JSON", value)
+}
+
+// Value implements the driver Valuer interface. The document
+// is passed as text after checking that it is valid JSON.
+func (ns JSON) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	if err := ns.validate(); err != nil {
+		return nil, err
+	}
+	return string(ns.JSON), nil
+}
+
+// MarshalJSON embeds the document as is in the surrounding JSON.
+func (ns JSON) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return json.Marshal(nil)
+	}
+	if err := ns.validate(); err != nil {
+		return nil, err
+	}
+	return ns.JSON, nil
+}
+
+// UnmarshalJSON keeps a copy of the document. The JSON null
+// literal is treated as SQL NULL, like for the other types.
+func (ns *JSON) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	if string(text) == "null" {
+		ns.JSON = nil
+		return nil
+	}
+	ns.JSON, ns.Valid = append(json.RawMessage{}, text...), true
+	return nil
+}
+
+// MarshalText marshals the document as text.
+func (ns JSON) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	if err := ns.validate(); err != nil {
+		return nil, err
+	}
+	return ns.JSON, nil
+}
+
+// UnmarshalText will unmarshal a JSON document given as text.
+func (ns *JSON) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	if len(text) == 0 || string(text) == "null" {
+		return nil
+	}
+	return decoded(ns, ns.parse(string(text)))
+}
+
+func (ns JSON) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !ns.Valid {
+		return nil
+	}
+	if err := ns.validate(); err != nil {
+		return err
+	}
+	return e.EncodeElement(string(ns.JSON), start)
+}
+
+func (ns *JSON) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(data))
+}
+
+func (ns JSON) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !ns.Valid {
+		return xml.Attr{}, nil
+	}
+	if err := ns.validate(); err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{
+		Name:  name,
+		Value: string(ns.JSON),
+	}, nil
+}
+
+func (ns *JSON) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(attr.Value))
+}
+
+func (ns JSON) validate() error {
+	if !json.Valid(ns.JSON) {
+		return fmt.Errorf("nulls: JSON holds invalid JSON %q", ns.JSON)
+	}
+	return nil
+}
+
+// parse sets the document from s after checking that
+// it is valid JSON.
+func (ns *JSON) parse(s string) error {
+	// unmarshaling into a RawMessage only checks the syntax
+	if err := json.Unmarshal([]byte(s), new(json.RawMessage)); err != nil {
+		return &DecodeError{Type: "JSON", Text: s, Err: err}
+	}
+	ns.JSON, ns.Valid = json.RawMessage(s), true
+	return nil
+}
@@ -0,0 +1,161 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+)
+
+// JSONOf is a nullable T that is stored as a JSON document,
+// i.e. a struct kept in a JSON/JSONB column. The database
+// holds the JSON text of T, while API JSON nests T as is.
+type JSONOf[T any] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// NewJSONOf returns a new, properly instantiated
+// JSONOf object.
+func NewJSONOf[T any](v T) JSONOf[T] {
+	return JSONOf[T]{V: v, Valid: true}
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the value is not valid, otherwise it returns the inner value.
+func (ns JSONOf[T]) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.V
+}
+
+// Scan implements the Scanner interface. It decodes the JSON
+// text held by a string or []byte into a new T.
+func (ns *JSONOf[T]) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		var zero T
+		ns.V, ns.Valid = zero, false
+		return nil
+	case string:
+		return ns.decode([]byte(v))
+	case []byte:
+		return ns.decode(v)
+	}
+	return fmt.Errorf("nulls: cannot scan %T into %s", value, ns.typeName())
+}
+
+// Value implements the driver Valuer interface. The value
+// is passed as JSON text.
+func (ns JSONOf[T]) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	b, err := ns.encode()
+	return string(b), err
+}
+
+// MarshalJSON nests the value in the surrounding JSON.
+func (ns JSONOf[T]) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return json.Marshal(nil)
+	}
+	return ns.encode()
+}
+
+// UnmarshalJSON will unmarshal a nested JSON value into T.
+func (ns *JSONOf[T]) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	if string(text) == "null" {
+		return nil
+	}
+	return decoded(ns, ns.decode(text))
+}
+
+// MarshalText marshals the value to its JSON text.
+func (ns JSONOf[T]) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	return ns.encode()
+}
+
+// UnmarshalText will unmarshal JSON text into T.
+func (ns *JSONOf[T]) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	if len(text) == 0 || string(text) == "null" {
+		return nil
+	}
+	return decoded(ns, ns.decode(text))
+}
+
+func (ns JSONOf[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !ns.Valid {
+		return nil
+	}
+	b, err := ns.encode()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(b), start)
+}
+
+func (ns *JSONOf[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.decode([]byte(data)))
+}
+
+func (ns JSONOf[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !ns.Valid {
+		return xml.Attr{}, nil
+	}
+	b, err := ns.encode()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{
+		Name:  name,
+		Value: string(b),
+	}, nil
+}
+
+func (ns *JSONOf[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.decode([]byte(attr.Value)))
+}
+
+func (ns JSONOf[T]) encode() ([]byte, error) {
+	b, err := json.Marshal(ns.V)
+	if err != nil {
+		return nil, fmt.Errorf("nulls: cannot marshal %s: %w", ns.typeName(), err)
+	}
+	return b, nil
+}
+
+// decode sets the value from the JSON text b.
+func (ns *JSONOf[T]) decode(b []byte) error {
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return &DecodeError{Type: ns.typeName(), Text: string(b), Err: err}
+	}
+	ns.V, ns.Valid = v, true
+	return nil
+}
+
+func (JSONOf[T]) typeName() string {
+	return "JSONOf[" + reflect.TypeOf((*T)(nil)).Elem().String() + "]"
+}
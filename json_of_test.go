@@ -0,0 +1,91 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	Street string `json:"street"`
+	Zip    int    `json:"zip"`
+}
+
+func TestJSONOf_Scan(t *testing.T) {
+	var ns JSONOf[address]
+	assert.NoError(t, ns.Scan(`{"street":"Main St","zip":12345}`))
+	assert.True(t, ns.Valid)
+	assert.Equal(t, address{Street: "Main St", Zip: 12345}, ns.V)
+
+	assert.NoError(t, ns.Scan([]byte(`{"street":"Elm St"}`)))
+	assert.Equal(t, address{Street: "Elm St"}, ns.V)
+
+	assert.NoError(t, ns.Scan(nil))
+	assert.False(t, ns.Valid)
+	assert.Equal(t, address{}, ns.V)
+
+	err := ns.Scan(`{"zip":"abc"}`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `JSONOf[nulls.address]`)
+	assert.Contains(t, err.Error(), `{\"zip\":\"abc\"}`)
+	var typeErr *json.UnmarshalTypeError
+	assert.ErrorAs(t, err, &typeErr)
+
+	assert.Error(t, ns.Scan(int64(1)))
+}
+
+func TestJSONOf_Value(t *testing.T) {
+	v, err := NewJSONOf(address{Street: "Main St", Zip: 1}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"street":"Main St","zip":1}`, v)
+
+	v, err = JSONOf[address]{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	_, err = NewJSONOf(map[string]interface{}{"c": make(chan int)}).Value()
+	assert.Error(t, err)
+}
+
+func TestJSONOf_JSON(t *testing.T) {
+	type user struct {
+		Address JSONOf[address] `json:"address"`
+	}
+
+	b, err := json.Marshal(user{Address: NewJSONOf(address{Street: "Main St", Zip: 1})})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"address":{"street":"Main St","zip":1}}`, string(b))
+
+	b, err = json.Marshal(user{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"address":null}`, string(b))
+
+	var u user
+	assert.NoError(t, json.Unmarshal([]byte(`{"address":{"street":"Elm St","zip":2}}`), &u))
+	assert.Equal(t, NewJSONOf(address{Street: "Elm St", Zip: 2}), u.Address)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"address":null}`), &u))
+	assert.False(t, u.Address.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"address":[1]}`), &u))
+}
+
+func TestJSONOf_XML(t *testing.T) {
+	type doc struct {
+		Address JSONOf[address] `xml:"address"`
+	}
+
+	in := doc{Address: NewJSONOf(address{Street: "Main St", Zip: 1})}
+	b, err := xml.Marshal(in)
+	assert.NoError(t, err)
+
+	var out doc
+	assert.NoError(t, xml.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+
+	b, err = xml.Marshal(doc{})
+	assert.NoError(t, err)
+	assert.Equal(t, `<doc></doc>`, string(b))
+}
@@ -0,0 +1,121 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON_Scan(t *testing.T) {
+	var ns JSON
+	assert.NoError(t, ns.Scan(`{"a":1}`))
+	assert.True(t, ns.Valid)
+	assert.Equal(t, `{"a":1}`, string(ns.JSON))
+
+	b := []byte(`[1,2]`)
+	assert.NoError(t, ns.Scan(b))
+	b[0] = '{'
+	assert.Equal(t, `[1,2]`, string(ns.JSON))
+
+	assert.NoError(t, ns.Scan("null"))
+	assert.True(t, ns.Valid)
+	assert.True(t, ns.IsJSONNull())
+
+	assert.NoError(t, ns.Scan(nil))
+	assert.False(t, ns.Valid)
+	assert.False(t, ns.IsJSONNull())
+
+	assert.Error(t, ns.Scan(int64(1)))
+}
+
+func TestJSON_Value(t *testing.T) {
+	v, err := NewJSON(json.RawMessage(`{"a":1}`)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, v)
+
+	v, err = NewJSON(json.RawMessage(`null`)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "null", v)
+
+	v, err = JSON{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	_, err = NewJSON(json.RawMessage(`{"a":`)).Value()
+	assert.Error(t, err)
+}
+
+func TestJSON_MarshalJSON(t *testing.T) {
+	type payload struct {
+		Data JSON `json:"data"`
+	}
+
+	b, err := json.Marshal(payload{Data: NewJSON(json.RawMessage(`{"a":[1,2]}`))})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"data":{"a":[1,2]}}`, string(b))
+
+	b, err = json.Marshal(payload{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"data":null}`, string(b))
+
+	_, err = json.Marshal(payload{Data: NewJSON(json.RawMessage(`nope`))})
+	assert.Error(t, err)
+}
+
+func TestJSON_UnmarshalJSON(t *testing.T) {
+	type payload struct {
+		Data JSON `json:"data"`
+	}
+
+	var p payload
+	assert.NoError(t, json.Unmarshal([]byte(`{"data": {"a": "b"}}`), &p))
+	assert.True(t, p.Data.Valid)
+	assert.Equal(t, `{"a": "b"}`, string(p.Data.JSON))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"data":null}`), &p))
+	assert.False(t, p.Data.Valid)
+}
+
+func TestJSON_Text(t *testing.T) {
+	var ns JSON
+	assert.NoError(t, ns.UnmarshalText([]byte(`{"a":1}`)))
+	assert.True(t, ns.Valid)
+
+	b, err := ns.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(b))
+
+	assert.NoError(t, ns.UnmarshalText([]byte("")))
+	assert.False(t, ns.Valid)
+
+	assert.Error(t, ns.UnmarshalText([]byte(`{"a":`)))
+}
+
+func TestJSON_XML(t *testing.T) {
+	type doc struct {
+		XMLName xml.Name `xml:"doc"`
+		Attr    JSON     `xml:"attr,attr"`
+		Elem    JSON     `xml:"elem"`
+	}
+
+	in := doc{
+		Attr: NewJSON(json.RawMessage(`[1]`)),
+		Elem: NewJSON(json.RawMessage(`{"a":"<b>"}`)),
+	}
+	b, err := xml.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `<doc attr="[1]"><elem>{&#34;a&#34;:&#34;&lt;b&gt;&#34;}</elem></doc>`, string(b))
+
+	var out doc
+	assert.NoError(t, xml.Unmarshal(b, &out))
+	assert.Equal(t, in.Attr, out.Attr)
+	assert.Equal(t, in.Elem, out.Elem)
+
+	b, err = xml.Marshal(doc{})
+	assert.NoError(t, err)
+	assert.Equal(t, `<doc></doc>`, string(b))
+
+	assert.Error(t, xml.Unmarshal([]byte(`<doc><elem>{</elem></doc>`), &out))
+}
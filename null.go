@@ -0,0 +1,628 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FlexibleNumbers makes the numeric types accept JSON strings
+// holding a number, i.e. "42", and exponent notation for
+// integers, i.e. 1e3. Integers must still be integral, so
+// 1.5 is rejected for Int64.
+var FlexibleNumbers = false
+
+// NonFinitePolicy selects how NaN and infinite floats, which
+// JSON cannot represent, are encoded.
+type NonFinitePolicy int
+
+const (
+	// NonFiniteError fails encoding, like encoding/json does.
+	NonFiniteError NonFinitePolicy = iota
+	// NonFiniteNull encodes them like an invalid value.
+	NonFiniteNull
+	// NonFiniteString encodes them as "NaN", "Infinity" and
+	// "-Infinity", which are also accepted when decoding JSON.
+	NonFiniteString
+)
+
+// FloatNonFinite is the policy Float32 and Float64 apply to
+// NaN and infinite values in JSON, text and XML.
+var FloatNonFinite = NonFiniteError
+
+// ScanNaNAsNull makes Scan turn NaN, i.e. Postgres 'NaN'::float8,
+// into an invalid Float32 or Float64.
+var ScanNaNAsNull = false
+
+// FloatStyle selects how floats are rendered.
+type FloatStyle int
+
+const (
+	// ShortestFloat renders the shortest text that parses back
+	// to the same value for the bit size of the type, i.e. 3.22
+	// for float32(3.22), in the notation of encoding/json.
+	ShortestFloat FloatStyle = iota
+	// FixedFloat renders Precision fractional digits, i.e. "3.20".
+	FixedFloat
+	// SignificantFloat renders Precision significant digits,
+	// i.e. "3.2" or "1.2e+06".
+	SignificantFloat
+)
+
+// FloatFormat is the style and precision of rendered floats.
+type FloatFormat struct {
+	Style     FloatStyle
+	Precision int // ignored by ShortestFloat
+}
+
+// Float32Format and Float64Format are the formats Float32 and
+// Float64 use for JSON, text and XML. Null[float32] and
+// Null[float64] use them as well.
+var (
+	Float32Format FloatFormat
+	Float64Format FloatFormat
+)
+
+// jsonNumberPattern matches the JSON number grammar.
+var jsonNumberPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// Null is a generic nullable value that supports proper
+// SQL, JSON, XML and text encoding/decoding for any scalar T.
+// The named types of this package (Int64, String, ...) are
+// thin wrappers around it.
+type Null[T any] sql.Null[T]
+
+// NewNull returns a new, properly instantiated
+// Null object.
+func NewNull[T any](v T) Null[T] {
+	return Null[T]{V: v, Valid: true}
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the value is not valid, otherwise it returns the inner value.
+func (ns Null[T]) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.V
+}
+
+// Scan implements the Scanner interface. Numbers that
+// do not fit into T are reported as *RangeError.
+func (ns *Null[T]) Scan(value interface{}) error {
+	if text, ok := numberText(value); ok && (isNumberType[T]() || isBoolType[T]()) {
+		var v T
+		parse := parseText
+		if _, ok := value.(float64); ok {
+			parse = parseNumber
+		}
+		if err := parse(text, &v); err != nil {
+			return err
+		}
+		ns.V, ns.Valid = v, !(ScanNaNAsNull && isNaN(v))
+		return nil
+	}
+
+	n := sql.Null[T]{V: ns.V}
+	err := n.Scan(value)
+	ns.V, ns.Valid = n.V, n.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface. Unsigned
+// values above math.MaxInt64, which do not fit into a
+// driver.Value integer, are passed as decimal text.
+func (ns Null[T]) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	if _, ok := interface{}(ns.V).(driver.Valuer); !ok {
+		rv := reflect.ValueOf(ns.V)
+		switch rv.Kind() {
+		case reflect.Uint, reflect.Uint64:
+			if u := rv.Uint(); u > math.MaxInt64 {
+				return strconv.FormatUint(u, 10), nil
+			}
+		}
+	}
+	return driver.DefaultParameterConverter.ConvertValue(ns.V)
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns Null[T]) MarshalJSON() ([]byte, error) {
+	ns, token, err := ns.nonFinite()
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		return json.Marshal(token)
+	}
+	if !ns.Valid {
+		return json.Marshal(nil)
+	}
+	if isNumberType[T]() && isFloat(reflect.TypeOf(ns.V).Kind()) {
+		s, err := formatText(ns.V)
+		return []byte(s), err
+	}
+	return json.Marshal(ns.V)
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the proper representation of that value.
+func (ns *Null[T]) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	if string(text) == "null" {
+		return nil
+	}
+	var v T
+	var err error
+	switch {
+	case isNumberType[T]():
+		// parsed by hand to report *RangeError
+		err = parseNumberJSON(text, &v)
+	case isBoolType[T]():
+		err = parseBoolJSON(text, &v)
+	default:
+		err = json.Unmarshal(text, &v)
+	}
+	if err != nil {
+		return decoded(ns, ns.decodeError(string(text), err))
+	}
+	ns.V, ns.Valid = v, true
+	return nil
+}
+
+// MarshalText marshals the underlying value to its
+// text representation. An invalid value is empty.
+func (ns Null[T]) MarshalText() ([]byte, error) {
+	ns, token, err := ns.nonFinite()
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		return []byte(token), nil
+	}
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	s, err := formatText(ns.V)
+	return []byte(s), err
+}
+
+// UnmarshalText will unmarshal text value into
+// the proper representation of that value. The empty
+// string and "null" are treated as null.
+func (ns *Null[T]) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	var v T
+	if err := parseText(t, &v); err != nil {
+		return decoded(ns, ns.decodeError(t, err))
+	}
+	ns.V, ns.Valid = v, true
+	return nil
+}
+
+// MarshalXML omits the element if the value is not valid.
+func (ns Null[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	ns, token, err := ns.nonFinite()
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		return e.EncodeElement(token, start)
+	}
+	if !ns.Valid {
+		return nil
+	}
+	if isNumberType[T]() && isFloat(reflect.TypeOf(ns.V).Kind()) {
+		s, err := formatText(ns.V)
+		if err != nil {
+			return err
+		}
+		return e.EncodeElement(s, start)
+	}
+	return e.EncodeElement(ns.V, start)
+}
+
+// UnmarshalXML leaves the value untouched if the element
+// is empty or holds "null".
+func (ns *Null[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	var v T
+	if err := parseText(data, &v); err != nil {
+		return decoded(ns, ns.decodeError(data, err))
+	}
+
+	ns.Valid = true
+	ns.V = v
+
+	return nil
+}
+
+// MarshalXMLAttr omits the attribute if the value is not valid.
+func (ns Null[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	ns, token, err := ns.nonFinite()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	if token != "" {
+		return xml.Attr{Name: name, Value: token}, nil
+	}
+	if !ns.Valid {
+		return xml.Attr{}, nil
+	}
+	value, err := formatText(ns.V)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{
+		Name:  name,
+		Value: value,
+	}, nil
+}
+
+// UnmarshalXMLAttr leaves the value untouched if the attribute
+// is empty or holds "null".
+func (ns *Null[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	var v T
+	if err := parseText(attr.Value, &v); err != nil {
+		return decoded(ns, ns.decodeError(attr.Value, err))
+	}
+
+	ns.Valid = true
+	ns.V = v
+
+	return nil
+}
+
+// nonFinite applies FloatNonFinite to a NaN or infinite V. It
+// returns ns invalidated for NonFiniteNull, or the token to
+// encode for NonFiniteString. Other values are returned as is.
+func (ns Null[T]) nonFinite() (Null[T], string, error) {
+	if !ns.Valid {
+		return ns, "", nil
+	}
+	rv := reflect.ValueOf(ns.V)
+	if !isFloat(rv.Kind()) {
+		return ns, "", nil
+	}
+
+	var token string
+	switch f := rv.Float(); {
+	case math.IsNaN(f):
+		token = "NaN"
+	case math.IsInf(f, 1):
+		token = "Infinity"
+	case math.IsInf(f, -1):
+		token = "-Infinity"
+	default:
+		return ns, "", nil
+	}
+
+	switch FloatNonFinite {
+	case NonFiniteNull:
+		return Null[T]{V: ns.V}, "", nil
+	case NonFiniteString:
+		return ns, token, nil
+	}
+	return ns, "", fmt.Errorf("nulls: cannot encode %s %s, see FloatNonFinite", token, rv.Type())
+}
+
+func isFloat(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func isNaN(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if !isFloat(rv.Kind()) {
+		return false
+	}
+	return math.IsNaN(rv.Float())
+}
+
+// decodeError reports that text cannot be decoded into T.
+// A *RangeError is returned as is.
+func (ns Null[T]) decodeError(text string, err error) error {
+	var re *RangeError
+	if errors.As(err, &re) {
+		return err
+	}
+	return &DecodeError{
+		Type: "Null[" + reflect.TypeOf((*T)(nil)).Elem().String() + "]",
+		Text: text,
+		Err:  err,
+	}
+}
+
+// parseText parses the text form of a scalar into the value
+// pointed to by v. encoding.TextUnmarshaler takes precedence
+// over the kind of the value.
+func parseText(s string, v interface{}) error {
+	if u, ok := v.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, ok := BoolTokens[strings.ToLower(strings.TrimSpace(s))]
+		if !ok {
+			return fmt.Errorf("%q is not in BoolTokens", s)
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return numError(s, rv.Type(), err)
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			if _, ierr := strconv.ParseInt(s, 10, 64); ierr == nil {
+				// a negative number
+				return &RangeError{Value: s, Type: rv.Type().String()}
+			}
+			return numError(s, rv.Type(), err)
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return numError(s, rv.Type(), err)
+		}
+		rv.SetFloat(f)
+	default:
+		return fmt.Errorf("nulls: cannot parse text into %s", rv.Type())
+	}
+	return nil
+}
+
+// parseBoolJSON parses a JSON boolean, number or string
+// into the value pointed to by v, looking it up in BoolTokens.
+func parseBoolJSON(text []byte, v interface{}) error {
+	s := string(text)
+	if len(s) > 1 && s[0] == '"' {
+		if err := json.Unmarshal(text, &s); err != nil {
+			return err
+		}
+	}
+	return parseText(s, v)
+}
+
+// parseNumberJSON parses a JSON number into the value pointed
+// to by v, honouring FlexibleNumbers.
+func parseNumberJSON(text []byte, v interface{}) error {
+	s := string(text)
+	float := isFloat(reflect.ValueOf(v).Elem().Kind())
+	if float && FloatNonFinite == NonFiniteString {
+		switch s {
+		case `"NaN"`, `"Infinity"`, `"-Infinity"`:
+			return parseText(s[1:len(s)-1], v)
+		}
+	}
+	if !FlexibleNumbers {
+		return parseText(s, v)
+	}
+
+	if len(s) > 1 && s[0] == '"' {
+		if err := json.Unmarshal(text, &s); err != nil {
+			return err
+		}
+	}
+	// strconv also takes "inf", "NaN" and hex floats,
+	// which are not JSON numbers
+	if !jsonNumberPattern.MatchString(s) {
+		return fmt.Errorf("%q is not a JSON number", s)
+	}
+
+	return parseNumber(s, v)
+}
+
+// parseNumber parses a number in decimal or exponent notation
+// into the value pointed to by v. Integer kinds take numbers
+// without a fractional part, i.e. "1e3".
+func parseNumber(s string, v interface{}) error {
+	if isFloat(reflect.ValueOf(v).Elem().Kind()) || !strings.ContainsAny(s, ".eE") {
+		return parseText(s, v)
+	}
+
+	i, ok := integerText(s)
+	if !ok {
+		return fmt.Errorf("%s is not an integer", s)
+	}
+	err := parseText(i, v)
+	var re *RangeError
+	if errors.As(err, &re) {
+		return &RangeError{Value: s, Type: re.Type}
+	}
+	return err
+}
+
+// integerText rewrites a number in decimal or exponent notation,
+// i.e. "12.0" or "1.5e3", as the integer it denotes. ok is false
+// if the number is malformed or has a fractional part.
+func integerText(s string) (string, bool) {
+	sign := ""
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		if s[0] == '-' {
+			sign = "-"
+		}
+		s = s[1:]
+	}
+
+	mant, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(strings.TrimPrefix(s[i+1:], "+"))
+		if err != nil {
+			return "", false
+		}
+		mant, exp = s[:i], e
+	}
+
+	intPart, frac := mant, ""
+	if i := strings.IndexByte(mant, '.'); i >= 0 {
+		intPart, frac = mant[:i], mant[i+1:]
+	}
+	digits := intPart + frac
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return "", false
+	}
+
+	// digits before point are the integer, the rest must be zeros
+	point := len(intPart) + exp
+	switch {
+	case point <= 0:
+		point = 0
+	case point > len(digits):
+		// 64 zeros are out of range for any non-zero integer
+		pad := point - len(digits)
+		if pad > 64 {
+			pad = 64
+		}
+		digits += strings.Repeat("0", pad)
+		point = len(digits)
+	}
+	if strings.Trim(digits[point:], "0") != "" {
+		return "", false
+	}
+	if strings.Trim(digits[:point], "0") == "" {
+		return "0", true
+	}
+	return sign + digits[:point], true
+}
+
+// numError turns strconv range errors into *RangeError.
+func numError(s string, t reflect.Type, err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return &RangeError{Value: s, Type: t.String()}
+	}
+	return err
+}
+
+// isNumberType reports whether T is an integer or float kind
+// that is not decoded by methods of its own.
+func isNumberType[T any]() bool {
+	return !hasDecoder[T]() && isNumber(reflect.TypeOf((*T)(nil)).Elem().Kind())
+}
+
+// isBoolType reports whether T is a bool kind that is not
+// decoded by methods of its own.
+func isBoolType[T any]() bool {
+	return !hasDecoder[T]() && reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.Bool
+}
+
+// hasDecoder reports whether *T decodes itself.
+func hasDecoder[T any]() bool {
+	var v T
+	switch interface{}(&v).(type) {
+	case sql.Scanner, json.Unmarshaler, encoding.TextUnmarshaler:
+		return true
+	}
+	return false
+}
+
+// numberText returns the text of the driver values that
+// can hold a number.
+func numberText(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	}
+	return "", false
+}
+
+// formatText returns the text form of a scalar value.
+// encoding.TextMarshaler takes precedence over the kind
+// of the value.
+func formatText(v interface{}) (string, error) {
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return formatFloat(rv.Float(), rv.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("nulls: cannot format %T as text", v)
+}
+
+// formatFloat renders f of the given bit size in Float32Format
+// or Float64Format.
+func formatFloat(f float64, bits int) string {
+	format := Float64Format
+	if bits == 32 {
+		format = Float32Format
+	}
+
+	switch format.Style {
+	case FixedFloat:
+		return strconv.FormatFloat(f, 'f', format.Precision, bits)
+	case SignificantFloat:
+		return strconv.FormatFloat(f, 'g', format.Precision, bits)
+	}
+
+	// like encoding/json, use exponents for very small and
+	// very large numbers only
+	verb := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			verb = 'e'
+		}
+	}
+	b := strconv.AppendFloat(nil, f, verb, -1, bits)
+	if verb == 'e' {
+		// clean up e-09 to e-9
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,181 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullValid_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(NewNull(int16(42)))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "42", string(data))
+}
+
+func TestNullInvalid_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Null[int16]{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "null", string(data))
+}
+
+func TestNull_UnmarshalJSON(t *testing.T) {
+	var val Null[uint8]
+
+	assert.NoError(t, json.Unmarshal([]byte("200"), &val))
+	assert.Equal(t, true, val.Valid)
+	assert.Equal(t, uint8(200), val.V)
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &val))
+	assert.Equal(t, false, val.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte("300"), &val))
+	assert.Equal(t, false, val.Valid)
+}
+
+func TestNull_Scan(t *testing.T) {
+	var val Null[int16]
+
+	assert.NoError(t, val.Scan(int64(12)))
+	assert.Equal(t, true, val.Valid)
+	assert.Equal(t, int16(12), val.V)
+
+	assert.NoError(t, val.Scan(nil))
+	assert.Equal(t, false, val.Valid)
+}
+
+func TestNull_Value(t *testing.T) {
+	v, err := NewNull(uint16(7)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), v)
+
+	v, err = Null[uint16]{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = NewNull(uint64(math.MaxUint64)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "18446744073709551615", v)
+}
+
+func TestNull_BoolTokens(t *testing.T) {
+	var val Null[bool]
+
+	assert.NoError(t, val.UnmarshalText([]byte("yes")))
+	assert.Equal(t, NewNull(true), val)
+	assert.NoError(t, json.Unmarshal([]byte(`"off"`), &val))
+	assert.Equal(t, NewNull(false), val)
+	assert.NoError(t, json.Unmarshal([]byte("1"), &val))
+	assert.Equal(t, NewNull(true), val)
+	assert.NoError(t, val.Scan("N"))
+	assert.Equal(t, NewNull(false), val)
+	assert.NoError(t, val.Scan(int64(1)))
+	assert.Equal(t, NewNull(true), val)
+
+	assert.Error(t, val.UnmarshalText([]byte("maybe")))
+	assert.Error(t, json.Unmarshal([]byte(`"maybe"`), &val))
+	assert.Error(t, val.Scan("maybe"))
+}
+
+func TestNull_UnmarshalText(t *testing.T) {
+	var val Null[float64]
+
+	assert.NoError(t, val.UnmarshalText([]byte("3.5")))
+	assert.Equal(t, true, val.Valid)
+	assert.Equal(t, 3.5, val.V)
+
+	assert.NoError(t, val.UnmarshalText([]byte("")))
+	assert.Equal(t, false, val.Valid)
+}
+
+func TestNull_XMLRoundTrip(t *testing.T) {
+	type test struct {
+		Elem Null[int8]   `xml:"elem"`
+		Attr Null[string] `xml:"attr,attr"`
+		None Null[int8]   `xml:"none"`
+	}
+
+	val := test{
+		Elem: NewNull(int8(-3)),
+		Attr: NewNull("a"),
+	}
+
+	data, err := xml.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, "<test attr=\"a\"><elem>-3</elem></test>", string(data))
+
+	var out test
+	assert.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, val, out)
+}
+
+func TestFlexibleNumbers(t *testing.T) {
+	var i64 Int64
+	assert.Error(t, json.Unmarshal([]byte(`"42"`), &i64))
+	assert.Error(t, json.Unmarshal([]byte(`1e3`), &i64))
+
+	FlexibleNumbers = true
+	defer func() { FlexibleNumbers = false }()
+
+	for text, want := range map[string]int64{
+		`"42"`:    42,
+		`1e3`:     1000,
+		`"1.5e3"`: 1500,
+		`12.00`:   12,
+		`-2E+2`:   -200,
+		`1200e-2`: 12,
+		`"-0.0"`:  0,
+		`"9e18"`:  9000000000000000000,
+	} {
+		var ns Int64
+		assert.NoError(t, json.Unmarshal([]byte(text), &ns), text)
+		assert.Equal(t, NewInt64(want), ns, text)
+	}
+
+	for _, text := range []string{`1.5`, `"1.5"`, `15e-1`, `"abc"`, `"1e"`, `""`, `"  7"`, `"1e1000"`} {
+		var ns Int64
+		assert.Error(t, json.Unmarshal([]byte(text), &ns), text)
+	}
+
+	var i Int
+	assert.NoError(t, json.Unmarshal([]byte(`"2e2"`), &i))
+	assert.Equal(t, NewInt(200), i)
+
+	var i32 Int32
+	var re *RangeError
+	assert.ErrorAs(t, json.Unmarshal([]byte(`"1e10"`), &i32), &re)
+	assert.Equal(t, "1e10", re.Value)
+
+	var u32 UInt32
+	assert.NoError(t, json.Unmarshal([]byte(`"4e9"`), &u32))
+	assert.Equal(t, NewUInt32(4000000000), u32)
+	assert.ErrorAs(t, json.Unmarshal([]byte(`"-1"`), &u32), &re)
+
+	var f32 Float32
+	assert.NoError(t, json.Unmarshal([]byte(`"1.5"`), &f32))
+	assert.Equal(t, NewFloat32(1.5), f32)
+
+	var f64 Float64
+	assert.NoError(t, json.Unmarshal([]byte(`"2.5e-1"`), &f64))
+	assert.Equal(t, NewFloat64(0.25), f64)
+
+	// only the JSON number grammar is accepted in strings
+	for _, text := range []string{
+		`"NaN"`, `"nan"`, `"inf"`, `"-Inf"`, `"Infinity"`, `"0x1p-2"`,
+		`"+1"`, `".5"`, `"1."`, `"01"`, `"1_000"`, `" 1"`,
+	} {
+		f64 = Float64{}
+		assert.Error(t, json.Unmarshal([]byte(text), &f64), text)
+		assert.False(t, f64.Valid, text)
+
+		f32 = Float32{}
+		assert.Error(t, json.Unmarshal([]byte(text), &f32), text)
+
+		i64 := Int64{}
+		assert.Error(t, json.Unmarshal([]byte(text), &i64), text)
+	}
+}
@@ -1,9 +1,13 @@
 package nulls
 
 import (
+	"database/sql"
 	"database/sql/driver"
-
-	"github.com/gobuffalo/uuid"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
 )
 
 // nullable a generic representation of nulls type.
@@ -16,36 +20,106 @@ type nullable interface {
 // nullable interface. can be any of nulls.Int, nulls.uuid.UUID
 // nulls.String, etc.
 type Nulls struct {
-	Value interface{}
+	Nullable interface{}
 }
 
-// Interface calls Interface function for value.
+// Interface calls Interface function for value. It
+// returns nil if there is no wrapped nullable.
 func (nulls *Nulls) Interface() interface{} {
-	n := nulls.Value.(nullable)
-	return n.Interface()
+	if nulls.Nullable == nil {
+		return nil
+	}
+	return nulls.Nullable.(nullable).Interface()
 }
 
 // Parse parses the specified value to the corresponding
 // nullable type. value is one of the inner value hold
 // by a nullable type. i.e int, string, uuid.UUID etc.
-func (nulls *Nulls) Parse(value interface{}) interface{} {
-	switch nulls.Value.(type) {
-	case Int:
-		return NewInt(value.(int))
-	case Int64:
-		return NewInt64(value.(int64))
-	case UUID:
-		return NewUUID(value.(uuid.UUID))
-	default:
-		return value
+// An error is returned if the wrapped type is not registered
+// or value does not fit into it.
+func (nulls *Nulls) Parse(value interface{}) (interface{}, error) {
+	e, ok := lookupType(reflect.TypeOf(nulls.Nullable))
+	if !ok {
+		return nil, fmt.Errorf("nulls: %T is not registered", nulls.Nullable)
 	}
+	return e.parse(value)
 }
 
 // New returns a wrapper called nulls for the
-// interface passed as a param.
+// interface passed as a param. A raw value of a registered
+// inner type, i.e. int or time.Time, is wrapped into its
+// nullable type first. nil is returned for anything else.
 func New(i interface{}) *Nulls {
-	if _, ok := i.(nullable); !ok {
+	if _, ok := i.(nullable); ok {
+		return &Nulls{Nullable: i}
+	}
+	if i == nil {
 		return nil
 	}
-	return &Nulls{Value: i}
+	if e, ok := lookupInner(reflect.TypeOf(i)); ok {
+		return &Nulls{Nullable: e.wrap(i)}
+	}
+	return nil
+}
+
+// Scan implements the Scanner interface by scanning
+// into the wrapped nullable.
+func (nulls *Nulls) Scan(value interface{}) error {
+	return nulls.decode(func(p interface{}) error {
+		s, ok := p.(sql.Scanner)
+		if !ok {
+			return fmt.Errorf("nulls: %T is not a sql.Scanner", nulls.Nullable)
+		}
+		return s.Scan(value)
+	})
+}
+
+// Value implements the driver Valuer interface by
+// calling Value on the wrapped nullable.
+func (nulls Nulls) Value() (driver.Value, error) {
+	if nulls.Nullable == nil {
+		return nil, nil
+	}
+	return nulls.Nullable.(nullable).Value()
+}
+
+// MarshalJSON marshals the wrapped nullable.
+func (nulls Nulls) MarshalJSON() ([]byte, error) {
+	return json.Marshal(nulls.Nullable)
+}
+
+// UnmarshalJSON unmarshals text into the wrapped nullable.
+func (nulls *Nulls) UnmarshalJSON(text []byte) error {
+	return nulls.decode(func(p interface{}) error {
+		return json.Unmarshal(text, p)
+	})
+}
+
+// MarshalXML marshals the wrapped nullable.
+func (nulls Nulls) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if nulls.Nullable == nil {
+		return nil
+	}
+	return e.EncodeElement(nulls.Nullable, start)
+}
+
+// UnmarshalXML unmarshals the element into the wrapped nullable.
+func (nulls *Nulls) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return nulls.decode(func(p interface{}) error {
+		return d.DecodeElement(p, &start)
+	})
+}
+
+// decode calls fn with a pointer to a copy of the wrapped
+// nullable and stores the result back. The wrapped nullable
+// has to be set, as it determines the type to decode into.
+func (nulls *Nulls) decode(fn func(interface{}) error) error {
+	if nulls.Nullable == nil {
+		return errors.New("nulls: no nullable to decode into")
+	}
+	p := reflect.New(reflect.TypeOf(nulls.Nullable))
+	p.Elem().Set(reflect.ValueOf(nulls.Nullable))
+	err := fn(p.Interface())
+	nulls.Nullable = p.Elem().Interface()
+	return err
 }
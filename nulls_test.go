@@ -0,0 +1,125 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Nulls_Parse(t *testing.T) {
+	r := require.New(t)
+
+	v, err := New(String{}).Parse("foo")
+	r.NoError(err)
+	r.Equal(NewString("foo"), v)
+
+	v, err = New(Int64{}).Parse(42)
+	r.NoError(err)
+	r.Equal(NewInt64(42), v)
+
+	v, err = New(Bool{}).Parse(nil)
+	r.NoError(err)
+	r.Equal(Bool{}, v)
+
+	_, err = New(Int32{}).Parse("foo")
+	r.Error(err)
+
+	_, err = New(UInt32{}).Parse(-1)
+	r.Error(err)
+
+	_, err = New(Null[uint16]{}).Parse(uint16(1))
+	r.Error(err)
+}
+
+type level int
+
+func Test_Nulls_Register(t *testing.T) {
+	r := require.New(t)
+
+	Register(NewNull[level])
+
+	v, err := New(Null[level]{}).Parse(level(1))
+	r.NoError(err)
+	r.Equal(NewNull(level(1)), v)
+	r.Equal(NewNull(level(1)), New(level(1)).Nullable)
+}
+
+func Test_New(t *testing.T) {
+	r := require.New(t)
+
+	now := time.Now()
+	r.Equal(NewInt(1), New(1).Nullable)
+	r.Equal(NewString("foo"), New("foo").Nullable)
+	r.Equal(NewTime(now), New(now).Nullable)
+	r.Equal(NewInt64(1), New(NewInt64(1)).Nullable)
+	r.Nil(New(struct{}{}))
+	r.Nil(New(nil))
+}
+
+func Test_Nulls_Scan(t *testing.T) {
+	r := require.New(t)
+
+	n := New(Int64{})
+	r.NoError(n.Scan(int64(3)))
+	r.Equal(NewInt64(3), n.Nullable)
+
+	r.NoError(n.Scan(nil))
+	r.Equal(Int64{}, n.Nullable)
+
+	r.Error((&Nulls{}).Scan(int64(3)))
+}
+
+func Test_Nulls_Value(t *testing.T) {
+	r := require.New(t)
+
+	v, err := New("foo").Value()
+	r.NoError(err)
+	r.Equal("foo", v)
+
+	v, err = New(String{}).Value()
+	r.NoError(err)
+	r.Nil(v)
+
+	v, err = Nulls{}.Value()
+	r.NoError(err)
+	r.Nil(v)
+}
+
+func Test_Nulls_Interface(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(int64(3), New(NewInt64(3)).Interface())
+	r.Nil(New(Int64{}).Interface())
+	r.Nil((&Nulls{}).Interface())
+}
+
+func Test_Nulls_JSON(t *testing.T) {
+	r := require.New(t)
+
+	b, err := json.Marshal(New(42))
+	r.NoError(err)
+	r.Equal("42", string(b))
+
+	n := New(Int{})
+	r.NoError(json.Unmarshal([]byte("7"), n))
+	r.Equal(NewInt(7), n.Nullable)
+}
+
+func Test_Nulls_XML(t *testing.T) {
+	r := require.New(t)
+
+	type test struct {
+		Val *Nulls `xml:"val"`
+	}
+
+	b, err := xml.Marshal(test{Val: New("foo")})
+	r.NoError(err)
+	r.Equal("<test><val>foo</val></test>", string(b))
+
+	val := test{Val: New(String{})}
+	r.NoError(xml.Unmarshal(b, &val))
+	r.Equal(NewString("foo"), val.Val.Nullable)
+}
@@ -0,0 +1,157 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+)
+
+// Optional wraps a nullable type, i.e. Optional[String], to
+// tell apart a field that was not sent from one sent as null
+// in a PATCH payload:
+//
+//	absent:  Set is false
+//	null:    Set is true, Nullable is not valid
+//	value:   Set is true, Nullable is valid
+//
+// Set is recorded by UnmarshalJSON and UnmarshalXML. ApplyPatch
+// copies the set fields onto a model struct.
+type Optional[N nullable] struct {
+	Nullable N
+	Set      bool // Set is true if the field was present
+}
+
+// NewOptional returns a new, properly instantiated
+// Optional object that is set to n.
+func NewOptional[N nullable](n N) Optional[N] {
+	return Optional[N]{Nullable: n, Set: true}
+}
+
+// IsNull reports whether the field was sent as null.
+func (o Optional[N]) IsNull() bool {
+	return o.Set && o.Nullable.Interface() == nil
+}
+
+// IsZero reports whether the field is absent, so that
+// `json:",omitzero"` leaves it out when encoding.
+func (o Optional[N]) IsZero() bool {
+	return !o.Set
+}
+
+// optional returns the wrapped nullable and whether it is set.
+// It lets ApplyPatch handle any Optional by reflection.
+func (o Optional[N]) optional() (interface{}, bool) {
+	return o.Nullable, o.Set
+}
+
+// MarshalJSON marshals the wrapped nullable. An absent
+// field is marshaled as null.
+func (o Optional[N]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.Nullable)
+}
+
+// UnmarshalJSON marks the field as set and unmarshals
+// the JSON value into the wrapped nullable.
+func (o *Optional[N]) UnmarshalJSON(text []byte) error {
+	var zero N
+	o.Nullable, o.Set = zero, true
+	if u, ok := interface{}(&o.Nullable).(json.Unmarshaler); ok {
+		return u.UnmarshalJSON(text)
+	}
+	return json.Unmarshal(text, &o.Nullable)
+}
+
+// MarshalXML omits the element if the field is absent.
+func (o Optional[N]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.Set {
+		return nil
+	}
+	return e.EncodeElement(o.Nullable, start)
+}
+
+// UnmarshalXML marks the field as set and decodes the element
+// into the wrapped nullable. An empty element is null.
+func (o *Optional[N]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var zero N
+	o.Nullable, o.Set = zero, true
+	return d.DecodeElement(&o.Nullable, &start)
+}
+
+// MarshalXMLAttr omits the attribute if the field is absent.
+func (o Optional[N]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.Set {
+		return xml.Attr{}, nil
+	}
+	if m, ok := interface{}(o.Nullable).(xml.MarshalerAttr); ok {
+		return m.MarshalXMLAttr(name)
+	}
+	return xml.Attr{}, fmt.Errorf("nulls: %T cannot be marshaled as XML attribute", o.Nullable)
+}
+
+// UnmarshalXMLAttr marks the field as set and decodes the
+// attribute into the wrapped nullable. An empty attribute
+// is null.
+func (o *Optional[N]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var zero N
+	o.Nullable, o.Set = zero, true
+	if u, ok := interface{}(&o.Nullable).(xml.UnmarshalerAttr); ok {
+		return u.UnmarshalXMLAttr(attr)
+	}
+	return fmt.Errorf("nulls: %T cannot be unmarshaled from XML attribute", o.Nullable)
+}
+
+// ApplyPatch copies every set Optional field of patch onto the
+// field of the same name of the struct pointed to by dst, which
+// must be of the wrapped nullable type. Absent fields and fields
+// that are not Optional are left alone.
+//
+//	type User struct {
+//		Name  nulls.String
+//		Email nulls.String
+//	}
+//
+//	type UserPatch struct {
+//		Name  nulls.Optional[nulls.String] `json:"name"`
+//		Email nulls.Optional[nulls.String] `json:"email"`
+//	}
+//
+//	err := nulls.ApplyPatch(&user, patch)
+func ApplyPatch(dst, patch interface{}) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("nulls: ApplyPatch needs a pointer to a struct, got %T", dst)
+	}
+	dv = dv.Elem()
+
+	pv := reflect.Indirect(reflect.ValueOf(patch))
+	if pv.Kind() != reflect.Struct {
+		return fmt.Errorf("nulls: ApplyPatch needs a struct patch, got %T", patch)
+	}
+
+	for i := 0; i < pv.NumField(); i++ {
+		sf := pv.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		o, ok := pv.Field(i).Interface().(interface{ optional() (interface{}, bool) })
+		if !ok {
+			continue
+		}
+		n, set := o.optional()
+		if !set {
+			continue
+		}
+
+		f := dv.FieldByName(sf.Name)
+		if !f.IsValid() || !f.CanSet() {
+			return fmt.Errorf("nulls: %s has no field %s to patch", dv.Type(), sf.Name)
+		}
+		v := reflect.ValueOf(n)
+		if v.Type() != f.Type() {
+			return fmt.Errorf("nulls: cannot patch %s.%s of type %s with %s", dv.Type(), sf.Name, f.Type(), v.Type())
+		}
+		f.Set(v)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type patchModel struct {
+	Name  String
+	Age   Int64
+	Email String
+}
+
+type modelPatch struct {
+	Name  Optional[String] `json:"name" xml:"name"`
+	Age   Optional[Int64]  `json:"age" xml:"age,attr"`
+	Email Optional[String] `json:"email" xml:"email"`
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	var p modelPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Mark","age":null}`), &p))
+
+	assert.True(t, p.Name.Set)
+	assert.Equal(t, NewString("Mark"), p.Name.Nullable)
+	assert.False(t, p.Name.IsNull())
+
+	assert.True(t, p.Age.Set)
+	assert.True(t, p.Age.IsNull())
+
+	assert.False(t, p.Email.Set)
+	assert.False(t, p.Email.IsNull())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"age":"x"}`), &p))
+}
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	type omit struct {
+		Name Optional[String] `json:"name,omitzero"`
+		Age  Optional[Int64]  `json:"age"`
+	}
+
+	b, err := json.Marshal(omit{Age: NewOptional(NewInt64(3))})
+	require.NoError(t, err)
+	assert.Equal(t, `{"age":3}`, string(b))
+
+	b, err = json.Marshal(omit{Name: NewOptional(String{})})
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":null,"age":null}`, string(b))
+}
+
+func TestOptional_XML(t *testing.T) {
+	var p modelPatch
+	require.NoError(t, xml.Unmarshal([]byte(`<patch age="4"><name></name></patch>`), &p))
+
+	assert.True(t, p.Name.Set)
+	assert.True(t, p.Name.IsNull())
+	assert.True(t, p.Age.Set)
+	assert.Equal(t, NewInt64(4), p.Age.Nullable)
+	assert.False(t, p.Email.Set)
+
+	b, err := xml.Marshal(modelPatch{
+		Name: NewOptional(NewString("Mark")),
+		Age:  NewOptional(NewInt64(4)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `<modelPatch age="4"><name>Mark</name></modelPatch>`, string(b))
+}
+
+func TestApplyPatch(t *testing.T) {
+	m := patchModel{
+		Name:  NewString("Mark"),
+		Age:   NewInt64(40),
+		Email: NewString("mark@example.com"),
+	}
+
+	var p modelPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Paul","email":null}`), &p))
+	require.NoError(t, ApplyPatch(&m, p))
+
+	assert.Equal(t, NewString("Paul"), m.Name)
+	assert.Equal(t, NewInt64(40), m.Age)
+	assert.False(t, m.Email.Valid)
+
+	require.NoError(t, ApplyPatch(&m, &modelPatch{}))
+	assert.Equal(t, NewString("Paul"), m.Name)
+
+	assert.Error(t, ApplyPatch(m, p))
+	assert.Error(t, ApplyPatch(&m, "patch"))
+
+	type wrongPatch struct {
+		Age Optional[Int32]
+	}
+	assert.Error(t, ApplyPatch(&m, wrongPatch{Age: NewOptional(NewInt32(1))}))
+
+	type unknownPatch struct {
+		Nickname Optional[String]
+	}
+	assert.Error(t, ApplyPatch(&m, unknownPatch{Nickname: NewOptional(NewString("M"))}))
+}
@@ -0,0 +1,115 @@
+package nulls
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// entry describes a registered nullable type.
+type entry struct {
+	typ   reflect.Type // the nullable type, i.e. Int64
+	inner reflect.Type // the wrapped type, i.e. int64
+	wrap  func(interface{}) nullable
+}
+
+var registry = struct {
+	sync.RWMutex
+	byType  map[reflect.Type]*entry
+	byInner map[reflect.Type]*entry
+}{
+	byType:  map[reflect.Type]*entry{},
+	byInner: map[reflect.Type]*entry{},
+}
+
+func init() {
+	// New wraps raw values into the first type registered for
+	// them, so types sharing an inner type come last.
+	Register(NewBool)
+	Register(NewByteSlice)
+	Register(NewDecimal)
+	Register(NewDuration)
+	Register(NewFloat32)
+	Register(NewFloat64)
+	Register(NewInt)
+	Register(NewInt8)
+	Register(NewInt16)
+	Register(NewInt32)
+	Register(NewInt64)
+	Register(NewJSON)
+	Register(NewString)
+	Register(NewTime)
+	Register(NewUInt)
+	Register(NewUInt8)
+	Register(NewUInt16)
+	Register(NewUInt32)
+	Register(NewUInt64)
+	Register(NewUUID)
+
+	Register(NewBase64ByteSlice)
+	Register(NewDate)
+	Register(NewInt64String)
+	Register(NewTimeOfDay)
+	Register(NewUInt64String)
+}
+
+// Register makes a nullable type known to Nulls.Parse and New.
+// wrap is the constructor of the nullable type, i.e. NewInt64.
+// The first type registered for an inner type is the one New
+// uses to auto-wrap raw values of that type.
+func Register[N nullable, T any](wrap func(T) N) {
+	e := &entry{
+		typ:   reflect.TypeOf((*N)(nil)).Elem(),
+		inner: reflect.TypeOf((*T)(nil)).Elem(),
+		wrap: func(v interface{}) nullable {
+			return wrap(v.(T))
+		},
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.byType[e.typ] = e
+	if _, ok := registry.byInner[e.inner]; !ok {
+		registry.byInner[e.inner] = e
+	}
+}
+
+func lookupType(t reflect.Type) (*entry, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	e, ok := registry.byType[t]
+	return e, ok
+}
+
+func lookupInner(t reflect.Type) (*entry, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	e, ok := registry.byInner[t]
+	return e, ok
+}
+
+// parse wraps value into the nullable type described by e.
+// A nil value yields the invalid zero value of the type.
+func (e *entry) parse(value interface{}) (interface{}, error) {
+	if value == nil {
+		return reflect.Zero(e.typ).Interface(), nil
+	}
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Type() == e.typ:
+		return value, nil
+	case v.Type() == e.inner:
+		return e.wrap(value), nil
+	case isNumber(v.Kind()) && isNumber(e.inner.Kind()):
+		// only accept conversions that do not lose information
+		c := v.Convert(e.inner)
+		if c.Convert(v.Type()).Interface() == value {
+			return e.wrap(c.Interface()), nil
+		}
+	}
+	return nil, fmt.Errorf("nulls: cannot parse %T into %s", value, e.typ)
+}
+
+func isNumber(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
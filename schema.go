@@ -21,6 +21,26 @@ func RegisterWithSchema(reg register) {
 		ns.Scan(s)
 		return reflect.ValueOf(ns)
 	})
+	reg(Base64ByteSlice{}, func(s string) reflect.Value {
+		ns := Base64ByteSlice{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(Date{}, func(s string) reflect.Value {
+		ns := Date{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(Decimal{}, func(s string) reflect.Value {
+		ns := Decimal{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(Duration{}, func(s string) reflect.Value {
+		ns := Duration{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
 	reg(Float32{}, func(s string) reflect.Value {
 		ns := Float32{}
 		ns.Scan(s)
@@ -36,6 +56,16 @@ func RegisterWithSchema(reg register) {
 		ns.Scan(s)
 		return reflect.ValueOf(ns)
 	})
+	reg(Int8{}, func(s string) reflect.Value {
+		ns := Int8{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(Int16{}, func(s string) reflect.Value {
+		ns := Int16{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
 	reg(Int32{}, func(s string) reflect.Value {
 		ns := Int32{}
 		ns.Scan(s)
@@ -46,14 +76,54 @@ func RegisterWithSchema(reg register) {
 		ns.Scan(s)
 		return reflect.ValueOf(ns)
 	})
+	reg(Int64String{}, func(s string) reflect.Value {
+		ns := Int64String{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(JSON{}, func(s string) reflect.Value {
+		ns := JSON{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
 	reg(Time{}, func(s string) reflect.Value {
 		ns := Time{}
 		ns.Scan(s)
 		return reflect.ValueOf(ns)
 	})
+	reg(TimeOfDay{}, func(s string) reflect.Value {
+		ns := TimeOfDay{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(UInt{}, func(s string) reflect.Value {
+		ns := UInt{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(UInt8{}, func(s string) reflect.Value {
+		ns := UInt8{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(UInt16{}, func(s string) reflect.Value {
+		ns := UInt16{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
 	reg(UInt32{}, func(s string) reflect.Value {
 		ns := UInt32{}
 		ns.Scan(s)
 		return reflect.ValueOf(ns)
 	})
+	reg(UInt64{}, func(s string) reflect.Value {
+		ns := UInt64{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
+	reg(UInt64String{}, func(s string) reflect.Value {
+		ns := UInt64String{}
+		ns.Scan(s)
+		return reflect.ValueOf(ns)
+	})
 }
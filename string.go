@@ -3,7 +3,6 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
 	"encoding/xml"
 )
 
@@ -14,10 +13,7 @@ type String sql.NullString
 // Interface implements the nullable interface. It returns nil if
 // the string is not valid, otherwise it returns the string value.
 func (ns String) Interface() interface{} {
-	if !ns.Valid {
-		return nil
-	}
-	return ns.String
+	return ns.null().Interface()
 }
 
 // NewString returns a new, properly instantiated
@@ -26,42 +22,36 @@ func NewString(s string) String {
 	return String{String: s, Valid: true}
 }
 
+func (ns String) null() Null[string] {
+	return Null[string]{V: ns.String, Valid: ns.Valid}
+}
+
 // Scan implements the Scanner interface.
 func (ns *String) Scan(value interface{}) error {
-	n := sql.NullString{String: ns.String}
+	n := ns.null()
 	err := n.Scan(value)
-	ns.String, ns.Valid = n.String, n.Valid
+	ns.String, ns.Valid = n.V, n.Valid
 	return err
 }
 
 // Value implements the driver Valuer interface.
 func (ns String) Value() (driver.Value, error) {
-	if !ns.Valid {
-		return nil, nil
-	}
-	return ns.String, nil
+	return ns.null().Value()
 }
 
 // MarshalJSON marshals the underlying value to a
 // proper JSON representation.
 func (ns String) MarshalJSON() ([]byte, error) {
-	if ns.Valid {
-		return json.Marshal(ns.String)
-	}
-	return json.Marshal(nil)
+	return ns.null().MarshalJSON()
 }
 
 // UnmarshalJSON will unmarshal a JSON value into
 // the propert representation of that value.
 func (ns *String) UnmarshalJSON(text []byte) error {
-	ns.Valid = false
-	if string(text) == "null" {
-		return nil
-	}
-	if err := json.Unmarshal(text, &ns.String); err == nil {
-		ns.Valid = true
-	}
-	return nil
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.String, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 // UnmarshalText will unmarshal text value into
@@ -78,54 +68,23 @@ func (ns *String) UnmarshalText(text []byte) error {
 }
 
 func (ns String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	if ns.Valid {
-		return e.EncodeElement(ns.String, start)
-	}
-	return nil
+	return ns.null().MarshalXML(e, start)
 }
 
 func (ns *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	var data string
-	err := d.DecodeElement(&data, &start)
-
-	if err != nil {
-		return err
-	}
-	if data == "" {
-		return nil
-	}
-
-	if data == "null" {
-		return nil
-	}
-
-	ns.Valid = true
-	ns.String = data
-
-	return nil
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.String, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
 }
 
 func (ns String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
-	if ns.Valid {
-		return xml.Attr{
-			Name:  name,
-			Value: ns.String,
-		}, nil
-	}
-	return xml.Attr{}, nil
+	return ns.null().MarshalXMLAttr(name)
 }
 
 func (ns *String) UnmarshalXMLAttr(attr xml.Attr) error {
-	if attr.Value == "" {
-		return nil
-	}
-
-	if attr.Value == "null" {
-		return nil
-	}
-
-	ns.Valid = true
-	ns.String = attr.Value
-
-	return nil
-}
\ No newline at end of file
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.String, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
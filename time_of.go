@@ -0,0 +1,283 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layouts understood by TimeOf besides the time package layouts.
+// They render the time as a number of seconds or milliseconds
+// since the Unix epoch.
+const (
+	UnixLayout      = "unix"
+	UnixMilliLayout = "unixmilli"
+)
+
+// TimeLayout is the layout Time uses for JSON, text and XML.
+var TimeLayout = time.RFC3339Nano
+
+// TimeFormat provides the layout of a TimeOf type. It is meant
+// to be implemented by empty structs, i.e.
+//
+//	type DayMonth struct{}
+//
+//	func (DayMonth) Layout() string { return "02/01/2006 15:04" }
+//
+//	var t nulls.TimeOf[DayMonth]
+type TimeFormat interface {
+	Layout() string
+}
+
+// DefaultTimeFormat uses the package level TimeLayout.
+type DefaultTimeFormat struct{}
+
+// Layout implements the TimeFormat interface.
+func (DefaultTimeFormat) Layout() string { return TimeLayout }
+
+// RFC3339Format renders the time as time.RFC3339.
+type RFC3339Format struct{}
+
+// Layout implements the TimeFormat interface.
+func (RFC3339Format) Layout() string { return time.RFC3339 }
+
+// RFC3339NanoFormat renders the time as time.RFC3339Nano.
+type RFC3339NanoFormat struct{}
+
+// Layout implements the TimeFormat interface.
+func (RFC3339NanoFormat) Layout() string { return time.RFC3339Nano }
+
+// UnixFormat renders the time as Unix seconds.
+type UnixFormat struct{}
+
+// Layout implements the TimeFormat interface.
+func (UnixFormat) Layout() string { return UnixLayout }
+
+// UnixMilliFormat renders the time as Unix milliseconds.
+type UnixMilliFormat struct{}
+
+// Layout implements the TimeFormat interface.
+func (UnixMilliFormat) Layout() string { return UnixMilliLayout }
+
+// TimeOf is a nullable time.Time that uses the layout of F
+// for JSON, text and XML encoding/decoding.
+type TimeOf[F TimeFormat] struct {
+	Time  time.Time
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// NewTimeOf returns a new, properly instantiated
+// TimeOf object.
+func NewTimeOf[F TimeFormat](t time.Time) TimeOf[F] {
+	return TimeOf[F]{Time: t, Valid: true}
+}
+
+// timeScanLayouts are the layouts Scan tries, in order,
+// on text values. Layouts without a zone are parsed as UTC.
+var timeScanLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the Time is not valid, otherwise it returns the Time value.
+func (ns TimeOf[F]) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.Time
+}
+
+// Scan implements the Scanner interface. Besides time.Time it
+// accepts strings and []byte in the common database layouts
+// and int64 Unix seconds. Unix seconds and layouts without a
+// zone give UTC times.
+func (ns *TimeOf[F]) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		ns.Time, ns.Valid = time.Time{}, false
+		return nil
+	case time.Time:
+		ns.Time, ns.Valid = v, true
+		return nil
+	case int64:
+		ns.Time, ns.Valid = time.Unix(v, 0).UTC(), true
+		return nil
+	case string:
+		return ns.scanText(v)
+	case []byte:
+		return ns.scanText(string(v))
+	}
+	return fmt.Errorf("nulls: cannot scan %T into Time", value)
+}
+
+func (ns *TimeOf[F]) scanText(s string) error {
+	for _, layout := range timeScanLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			ns.Time, ns.Valid = t, true
+			return nil
+		}
+	}
+	return fmt.Errorf("nulls: cannot scan %q into Time", s)
+}
+
+// Value implements the driver Valuer interface.
+func (ns TimeOf[F]) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return ns.Time, nil
+}
+
+// MarshalJSON marshals the time with the layout of F.
+// The Unix layouts are marshaled as JSON numbers, all
+// others as JSON strings.
+func (ns TimeOf[F]) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return json.Marshal(nil)
+	}
+	s := formatTime(ns.Time, ns.layout())
+	if isUnixLayout(ns.layout()) {
+		return []byte(s), nil
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON will unmarshal a JSON value in the layout
+// of F. The Unix layouts accept both numbers and strings.
+func (ns *TimeOf[F]) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	txt := string(text)
+	if txt == "null" || txt == "" {
+		return nil
+	}
+
+	if isUnixLayout(ns.layout()) && !strings.HasPrefix(txt, `"`) {
+		return decoded(ns, ns.parse(txt))
+	}
+
+	var s string
+	if err := json.Unmarshal(text, &s); err != nil {
+		return decoded(ns, &DecodeError{Type: ns.typeName(), Text: txt, Err: err})
+	}
+	return decoded(ns, ns.parse(s))
+}
+
+// MarshalText marshals the time with the layout of F.
+func (ns TimeOf[F]) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	return []byte(formatTime(ns.Time, ns.layout())), nil
+}
+
+// UnmarshalText will unmarshal text in the layout of F.
+func (ns *TimeOf[F]) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	return decoded(ns, ns.parse(t))
+}
+
+func (ns TimeOf[F]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if ns.Valid {
+		return e.EncodeElement(formatTime(ns.Time, ns.layout()), start)
+	}
+	return nil
+}
+
+func (ns *TimeOf[F]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(data))
+}
+
+func (ns TimeOf[F]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if ns.Valid {
+		return xml.Attr{
+			Name:  name,
+			Value: formatTime(ns.Time, ns.layout()),
+		}, nil
+	}
+	return xml.Attr{}, nil
+}
+
+func (ns *TimeOf[F]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(attr.Value))
+}
+
+func (ns TimeOf[F]) layout() string {
+	var f F
+	return f.Layout()
+}
+
+func (TimeOf[F]) typeName() string {
+	return "TimeOf[" + reflect.TypeOf((*F)(nil)).Elem().String() + "]"
+}
+
+// parse sets the time from s formatted with the layout of F.
+func (ns *TimeOf[F]) parse(s string) error {
+	t, err := parseTime(s, ns.layout())
+	if err != nil {
+		return &DecodeError{Type: ns.typeName(), Text: s, Err: err}
+	}
+	ns.Time = t
+	ns.Valid = true
+	return nil
+}
+
+func isUnixLayout(layout string) bool {
+	return layout == UnixLayout || layout == UnixMilliLayout
+}
+
+// formatTime formats t with layout, which can be one
+// of the Unix layouts.
+func formatTime(t time.Time, layout string) string {
+	switch layout {
+	case UnixLayout:
+		return strconv.FormatInt(t.Unix(), 10)
+	case UnixMilliLayout:
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	}
+	return t.Format(layout)
+}
+
+// parseTime parses s with layout, which can be one
+// of the Unix layouts. Unix times are returned in UTC.
+func parseTime(s, layout string) (time.Time, error) {
+	switch layout {
+	case UnixLayout, UnixMilliLayout:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if layout == UnixLayout {
+			return time.Unix(i, 0).UTC(), nil
+		}
+		return time.UnixMilli(i).UTC(), nil
+	}
+	return time.Parse(layout, s)
+}
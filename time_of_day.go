@@ -0,0 +1,196 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// TimeOfDayLayout is the layout of a TimeOfDay in JSON, text
+// and XML. Trailing zeros of the fraction are omitted.
+const TimeOfDayLayout = "15:04:05.999999999"
+
+// timeOfDayValueLayout is the layout passed to the database,
+// which stores TIME values with microsecond precision.
+const timeOfDayValueLayout = "15:04:05.999999"
+
+// timeOfDayLayouts are the layouts accepted when scanning
+// or decoding a TimeOfDay.
+var timeOfDayLayouts = []string{
+	TimeOfDayLayout,
+	"15:04",
+}
+
+// TimeOfDay adds an implementation for a clock time without
+// a date, i.e. TIME columns, that supports proper JSON
+// encoding/decoding.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Valid      bool // Valid is true if TimeOfDay is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the TimeOfDay is not valid, otherwise it returns the clock
+// time formatted as TimeOfDayLayout.
+func (ns TimeOfDay) Interface() interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.String()
+}
+
+// NewTimeOfDay returns a new, properly instantiated
+// TimeOfDay object for the clock time of t.
+func NewTimeOfDay(t time.Time) TimeOfDay {
+	return TimeOfDay{
+		Hour:       t.Hour(),
+		Minute:     t.Minute(),
+		Second:     t.Second(),
+		Nanosecond: t.Nanosecond(),
+		Valid:      true,
+	}
+}
+
+// On returns the clock time on the day of d in its location.
+func (ns TimeOfDay) On(d time.Time) time.Time {
+	y, m, day := d.Date()
+	return time.Date(y, m, day, ns.Hour, ns.Minute, ns.Second, ns.Nanosecond, d.Location())
+}
+
+// String returns the clock time formatted as TimeOfDayLayout,
+// or "null" if it is not valid.
+func (ns TimeOfDay) String() string {
+	if !ns.Valid {
+		return "null"
+	}
+	return ns.format(TimeOfDayLayout)
+}
+
+func (ns TimeOfDay) format(layout string) string {
+	return time.Date(0, 1, 1, ns.Hour, ns.Minute, ns.Second, ns.Nanosecond, time.UTC).Format(layout)
+}
+
+// Scan implements the Scanner interface. It accepts time.Time,
+// of which only the clock time is kept, as well as strings
+// and []byte in "15:04:05.999999999" or "15:04" layout.
+func (ns *TimeOfDay) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*ns = TimeOfDay{}
+		return nil
+	case time.Time:
+		*ns = NewTimeOfDay(v)
+		return nil
+	case string:
+		return ns.parse(v)
+	case []byte:
+		return ns.parse(string(v))
+	}
+	return fmt.Errorf("nulls: cannot scan %T into TimeOfDay", value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns TimeOfDay) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return ns.format(timeOfDayValueLayout), nil
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns TimeOfDay) MarshalJSON() ([]byte, error) {
+	if ns.Valid {
+		return json.Marshal(ns.String())
+	}
+	return json.Marshal(nil)
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the proper representation of that value.
+func (ns *TimeOfDay) UnmarshalJSON(text []byte) error {
+	ns.Valid = false
+	if string(text) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(text, &s); err != nil {
+		return decoded(ns, &DecodeError{Type: "TimeOfDay", Text: string(text), Err: err})
+	}
+	return decoded(ns, ns.parse(s))
+}
+
+// MarshalText marshals the underlying value to text.
+func (ns TimeOfDay) MarshalText() ([]byte, error) {
+	if !ns.Valid {
+		return []byte{}, nil
+	}
+	return []byte(ns.String()), nil
+}
+
+// UnmarshalText will unmarshal text value into
+// the proper representation of that value.
+func (ns *TimeOfDay) UnmarshalText(text []byte) error {
+	ns.Valid = false
+	t := string(text)
+	if t == "" || t == "null" {
+		return nil
+	}
+	return decoded(ns, ns.parse(t))
+}
+
+func (ns TimeOfDay) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if ns.Valid {
+		return e.EncodeElement(ns.String(), start)
+	}
+	return nil
+}
+
+func (ns *TimeOfDay) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var data string
+	err := d.DecodeElement(&data, &start)
+
+	if err != nil {
+		return err
+	}
+	if data == "" || data == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(data))
+}
+
+func (ns TimeOfDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if ns.Valid {
+		return xml.Attr{
+			Name:  name,
+			Value: ns.String(),
+		}, nil
+	}
+	return xml.Attr{}, nil
+}
+
+func (ns *TimeOfDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" || attr.Value == "null" {
+		return nil
+	}
+
+	return decoded(ns, ns.parse(attr.Value))
+}
+
+// parse sets the clock time from s in one of timeOfDayLayouts.
+func (ns *TimeOfDay) parse(s string) error {
+	for _, layout := range timeOfDayLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			*ns = NewTimeOfDay(t)
+			return nil
+		}
+	}
+	return &DecodeError{Type: "TimeOfDay", Text: s}
+}
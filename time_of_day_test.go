@@ -0,0 +1,66 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeOfDay_Scan(t *testing.T) {
+	want := TimeOfDay{Hour: 9, Minute: 30, Second: 15, Nanosecond: 250000000, Valid: true}
+	for _, value := range []interface{}{
+		"09:30:15.25",
+		[]byte("09:30:15.250000"),
+		time.Date(2019, 11, 25, 9, 30, 15, 250000000, time.UTC),
+	} {
+		var ns TimeOfDay
+		assert.NoError(t, ns.Scan(value), "%v", value)
+		assert.Equal(t, want, ns)
+	}
+
+	var ns TimeOfDay
+	assert.NoError(t, ns.Scan("09:30"))
+	assert.Equal(t, TimeOfDay{Hour: 9, Minute: 30, Valid: true}, ns)
+
+	assert.Error(t, ns.Scan("25:00:00"))
+	assert.NoError(t, ns.Scan(nil))
+	assert.Equal(t, false, ns.Valid)
+}
+
+func TestTimeOfDay_Value(t *testing.T) {
+	v, err := TimeOfDay{Hour: 18, Minute: 5, Nanosecond: 1500, Valid: true}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "18:05:00.000001", v)
+
+	v, err = TimeOfDay{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestTimeOfDay_JSONAndXML(t *testing.T) {
+	type test struct {
+		Elem TimeOfDay `json:"elem" xml:"elem"`
+		Attr TimeOfDay `json:"attr" xml:"attr,attr"`
+	}
+
+	val := test{Elem: TimeOfDay{Hour: 18, Minute: 5, Valid: true}}
+
+	data, err := json.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"elem":"18:05:00","attr":null}`, string(data))
+
+	var out test
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, val, out)
+
+	data, err = xml.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `<test><elem>18:05:00</elem></test>`, string(data))
+
+	out = test{}
+	assert.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, val, out)
+}
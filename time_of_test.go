@@ -0,0 +1,76 @@
+package nulls
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type dayMonthFormat struct{}
+
+func (dayMonthFormat) Layout() string { return "02/01/2006 15:04" }
+
+func TestTimeOf_JSON(t *testing.T) {
+	now := time.Date(2019, 11, 25, 13, 14, 0, 0, time.UTC)
+
+	data, err := json.Marshal(NewTimeOf[dayMonthFormat](now))
+	assert.NoError(t, err)
+	assert.Equal(t, `"25/11/2019 13:14"`, string(data))
+
+	var ns TimeOf[dayMonthFormat]
+	assert.NoError(t, json.Unmarshal(data, &ns))
+	assert.Equal(t, NewTimeOf[dayMonthFormat](now), ns)
+
+	data, err = json.Marshal(NewTimeOf[RFC3339Format](now))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2019-11-25T13:14:00Z"`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`"2019-11-25"`), &ns))
+	assert.Equal(t, false, ns.Valid)
+}
+
+func TestTimeOf_Unix(t *testing.T) {
+	now := time.Date(2019, 11, 25, 13, 14, 15, 0, time.UTC)
+
+	data, err := json.Marshal(NewTimeOf[UnixFormat](now))
+	assert.NoError(t, err)
+	assert.Equal(t, `1574687655`, string(data))
+
+	data, err = json.Marshal(NewTimeOf[UnixMilliFormat](now))
+	assert.NoError(t, err)
+	assert.Equal(t, `1574687655000`, string(data))
+
+	var ns TimeOf[UnixFormat]
+	for _, text := range []string{`1574687655`, `"1574687655"`} {
+		assert.NoError(t, json.Unmarshal([]byte(text), &ns))
+		assert.Equal(t, now, ns.Time)
+	}
+
+	type test struct {
+		Attr TimeOf[UnixFormat] `xml:"attr,attr"`
+	}
+	data, err = xml.Marshal(test{Attr: ns})
+	assert.NoError(t, err)
+	assert.Equal(t, `<test attr="1574687655"></test>`, string(data))
+}
+
+func TestTime_JSONLayout(t *testing.T) {
+	defer func(layout string) { TimeLayout = layout }(TimeLayout)
+
+	now := time.Date(2019, 11, 25, 13, 14, 15, 0, time.UTC)
+	data, err := json.Marshal(NewTime(now))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2019-11-25T13:14:15Z"`, string(data))
+
+	TimeLayout = "02/01/2006 15:04"
+	data, err = json.Marshal(NewTime(now))
+	assert.NoError(t, err)
+	assert.Equal(t, `"25/11/2019 13:14"`, string(data))
+
+	var ns Time
+	assert.NoError(t, ns.UnmarshalText([]byte("25/11/2019 13:14")))
+	assert.Equal(t, NewTime(now.Truncate(time.Minute)), ns)
+}
@@ -0,0 +1,104 @@
+package nulls
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTime_Scan(t *testing.T) {
+	want := time.Date(2019, 11, 25, 13, 14, 15, 0, time.UTC)
+	for _, value := range []interface{}{
+		want,
+		"2019-11-25T13:14:15Z",
+		"2019-11-25 13:14:15",
+		[]byte("2019-11-25 13:14:15"),
+		"2019-11-25 13:14:15+00:00",
+		"2019-11-25 15:14:15+02",
+		int64(1574687655),
+	} {
+		var ns Time
+		assert.NoError(t, ns.Scan(value), "%v", value)
+		assert.Equal(t, true, ns.Valid)
+		assert.True(t, want.Equal(ns.Time), "%v: %v", value, ns.Time)
+	}
+}
+
+func TestTime_ScanUnix(t *testing.T) {
+	var ns Time
+	assert.NoError(t, ns.Scan(int64(1574687655)))
+	assert.Equal(t, time.Date(2019, 11, 25, 13, 14, 15, 0, time.UTC), ns.Time)
+}
+
+func TestTime_ScanFraction(t *testing.T) {
+	var ns Time
+	assert.NoError(t, ns.Scan("2019-11-25 13:14:15.1234567"))
+	assert.Equal(t, 123456700, ns.Time.Nanosecond())
+}
+
+func TestTime_ScanDate(t *testing.T) {
+	var ns Time
+	assert.NoError(t, ns.Scan("2019-11-25"))
+	assert.Equal(t, time.Date(2019, 11, 25, 0, 0, 0, 0, time.UTC), ns.Time)
+}
+
+func TestTime_ScanInvalid(t *testing.T) {
+	ns := NewTime(time.Now())
+	assert.NoError(t, ns.Scan(nil))
+	assert.Equal(t, false, ns.Valid)
+
+	assert.Error(t, ns.Scan("yesterday"))
+	assert.Error(t, ns.Scan(1.5))
+}
+
+func TestTime_XMLRoundTrip(t *testing.T) {
+	type test struct {
+		Elem Time `xml:"elem"`
+		Attr Time `xml:"attr,attr"`
+	}
+
+	now := time.Date(2019, 11, 25, 13, 14, 15, 500, time.UTC)
+	val := test{Elem: NewTime(now), Attr: NewTime(now)}
+
+	data, err := xml.Marshal(val)
+	assert.NoError(t, err)
+	assert.Equal(t, `<test attr="2019-11-25T13:14:15.0000005Z"><elem>2019-11-25T13:14:15.0000005Z</elem></test>`, string(data))
+
+	var out test
+	assert.NoError(t, xml.Unmarshal(data, &out))
+	assert.Equal(t, val, out)
+}
+
+func TestTime_XMLLayout(t *testing.T) {
+	defer func(layout string) { TimeLayout = layout }(TimeLayout)
+	TimeLayout = "02/01/2006 15:04"
+
+	type test struct {
+		Attr Time `xml:"attr,attr"`
+	}
+
+	var out test
+	assert.NoError(t, xml.Unmarshal([]byte(`<test attr="25/11/2019 13:14"></test>`), &out))
+	assert.Equal(t, time.Date(2019, 11, 25, 13, 14, 0, 0, time.UTC), out.Attr.Time)
+
+	data, err := xml.Marshal(out)
+	assert.NoError(t, err)
+	assert.Equal(t, `<test attr="25/11/2019 13:14"></test>`, string(data))
+}
+
+func TestTime_UnmarshalXMLInvalid(t *testing.T) {
+	type test struct {
+		Elem Time `xml:"elem"`
+		Attr Time `xml:"attr,attr"`
+	}
+
+	var out test
+	assert.Error(t, xml.Unmarshal([]byte(`<test><elem>yesterday</elem></test>`), &out))
+	assert.Error(t, xml.Unmarshal([]byte(`<test attr="yesterday"></test>`), &out))
+
+	assert.NoError(t, xml.Unmarshal([]byte(`<test attr="null"><elem></elem></test>`), &out))
+	assert.Equal(t, false, out.Elem.Valid)
+	assert.Equal(t, false, out.Attr.Valid)
+}
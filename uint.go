@@ -0,0 +1,88 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// UInt adds an implementation for uint
+// that supports proper JSON encoding/decoding.
+type UInt struct {
+	UInt  uint
+	Valid bool // Valid is true if UInt is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the uint is not valid, otherwise it returns the uint value.
+func (ns UInt) Interface() interface{} {
+	return ns.null().Interface()
+}
+
+// NewUInt returns a new, properly instantiated
+// UInt object.
+func NewUInt(i uint) UInt {
+	return UInt{UInt: i, Valid: true}
+}
+
+func (ns UInt) null() Null[uint] {
+	return Null[uint]{V: ns.UInt, Valid: ns.Valid}
+}
+
+// Scan implements the Scanner interface.
+func (ns *UInt) Scan(value interface{}) error {
+	n := ns.null()
+	err := n.Scan(value)
+	ns.UInt, ns.Valid = n.V, n.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (ns UInt) Value() (driver.Value, error) {
+	return ns.null().Value()
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns UInt) MarshalJSON() ([]byte, error) {
+	return ns.null().MarshalJSON()
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the propert representation of that value.
+func (ns *UInt) UnmarshalJSON(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.UInt, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+// UnmarshalText will unmarshal text value into
+// the propert representation of that value.
+func (ns *UInt) UnmarshalText(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.UInt, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ns.null().MarshalXML(e, start)
+}
+
+func (ns *UInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.UInt, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return ns.null().MarshalXMLAttr(name)
+}
+
+func (ns *UInt) UnmarshalXMLAttr(attr xml.Attr) error {
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.UInt, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
@@ -0,0 +1,88 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// UInt16 adds an implementation for uint16
+// that supports proper JSON encoding/decoding.
+type UInt16 struct {
+	UInt16 uint16
+	Valid  bool // Valid is true if UInt16 is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the uint16 is not valid, otherwise it returns the uint16 value.
+func (ns UInt16) Interface() interface{} {
+	return ns.null().Interface()
+}
+
+// NewUInt16 returns a new, properly instantiated
+// UInt16 object.
+func NewUInt16(i uint16) UInt16 {
+	return UInt16{UInt16: i, Valid: true}
+}
+
+func (ns UInt16) null() Null[uint16] {
+	return Null[uint16]{V: ns.UInt16, Valid: ns.Valid}
+}
+
+// Scan implements the Scanner interface.
+func (ns *UInt16) Scan(value interface{}) error {
+	n := ns.null()
+	err := n.Scan(value)
+	ns.UInt16, ns.Valid = n.V, n.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (ns UInt16) Value() (driver.Value, error) {
+	return ns.null().Value()
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns UInt16) MarshalJSON() ([]byte, error) {
+	return ns.null().MarshalJSON()
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the propert representation of that value.
+func (ns *UInt16) UnmarshalJSON(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.UInt16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+// UnmarshalText will unmarshal text value into
+// the propert representation of that value.
+func (ns *UInt16) UnmarshalText(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.UInt16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ns.null().MarshalXML(e, start)
+}
+
+func (ns *UInt16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.UInt16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return ns.null().MarshalXMLAttr(name)
+}
+
+func (ns *UInt16) UnmarshalXMLAttr(attr xml.Attr) error {
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.UInt16, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
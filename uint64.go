@@ -0,0 +1,90 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// UInt64 adds an implementation for uint64
+// that supports proper JSON encoding/decoding.
+type UInt64 struct {
+	UInt64 uint64
+	Valid  bool // Valid is true if UInt64 is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the uint64 is not valid, otherwise it returns the uint64 value.
+func (ns UInt64) Interface() interface{} {
+	return ns.null().Interface()
+}
+
+// NewUInt64 returns a new, properly instantiated
+// UInt64 object.
+func NewUInt64(i uint64) UInt64 {
+	return UInt64{UInt64: i, Valid: true}
+}
+
+func (ns UInt64) null() Null[uint64] {
+	return Null[uint64]{V: ns.UInt64, Valid: ns.Valid}
+}
+
+// Scan implements the Scanner interface.
+func (ns *UInt64) Scan(value interface{}) error {
+	n := ns.null()
+	err := n.Scan(value)
+	ns.UInt64, ns.Valid = n.V, n.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface. Values above
+// math.MaxInt64, which do not fit into a driver.Value integer,
+// are passed as decimal text.
+func (ns UInt64) Value() (driver.Value, error) {
+	return ns.null().Value()
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns UInt64) MarshalJSON() ([]byte, error) {
+	return ns.null().MarshalJSON()
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the propert representation of that value.
+func (ns *UInt64) UnmarshalJSON(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.UInt64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+// UnmarshalText will unmarshal text value into
+// the propert representation of that value.
+func (ns *UInt64) UnmarshalText(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.UInt64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ns.null().MarshalXML(e, start)
+}
+
+func (ns *UInt64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.UInt64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return ns.null().MarshalXMLAttr(name)
+}
+
+func (ns *UInt64) UnmarshalXMLAttr(attr xml.Attr) error {
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.UInt64, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
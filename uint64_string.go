@@ -0,0 +1,87 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+)
+
+// UInt64String is a UInt64 that is encoded as a JSON string, i.e.
+// "18446744073709551615", so that JavaScript clients do not lose
+// precision above 2^53. Decoding accepts strings and numbers.
+// It works like the ",string" option of encoding/json, which
+// does not apply to types with their own marshalers.
+type UInt64String UInt64
+
+// Interface implements the nullable interface. It returns nil if
+// the uint64 is not valid, otherwise it returns the uint64 value.
+func (ns UInt64String) Interface() interface{} {
+	return UInt64(ns).Interface()
+}
+
+// NewUInt64String returns a new, properly instantiated
+// UInt64String object.
+func NewUInt64String(i uint64) UInt64String {
+	return UInt64String{UInt64: i, Valid: true}
+}
+
+// Scan implements the Scanner interface.
+func (ns *UInt64String) Scan(value interface{}) error {
+	return (*UInt64)(ns).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns UInt64String) Value() (driver.Value, error) {
+	return UInt64(ns).Value()
+}
+
+// MarshalJSON marshals the underlying value to
+// a JSON string.
+func (ns UInt64String) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(strconv.FormatUint(ns.UInt64, 10))
+}
+
+// UnmarshalJSON will unmarshal a JSON string or
+// number into the uint64.
+func (ns *UInt64String) UnmarshalJSON(text []byte) error {
+	if len(text) > 1 && text[0] == '"' {
+		var s string
+		if err := json.Unmarshal(text, &s); err != nil {
+			ns.Valid = false
+			return decoded(ns, &DecodeError{Type: "UInt64String", Text: string(text), Err: err})
+		}
+		// a quoted null or empty string is not a number
+		if s == "" || s == "null" {
+			ns.Valid = false
+			return decoded(ns, &DecodeError{Type: "UInt64String", Text: string(text)})
+		}
+		text = []byte(s)
+	}
+	return decoded(ns, (*UInt64)(ns).UnmarshalJSON(text))
+}
+
+// UnmarshalText will unmarshal text value into
+// the proper representation of that value.
+func (ns *UInt64String) UnmarshalText(text []byte) error {
+	return decoded(ns, (*UInt64)(ns).UnmarshalText(text))
+}
+
+func (ns UInt64String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return UInt64(ns).MarshalXML(e, start)
+}
+
+func (ns *UInt64String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return decoded(ns, (*UInt64)(ns).UnmarshalXML(d, start))
+}
+
+func (ns UInt64String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return UInt64(ns).MarshalXMLAttr(name)
+}
+
+func (ns *UInt64String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return decoded(ns, (*UInt64)(ns).UnmarshalXMLAttr(attr))
+}
@@ -0,0 +1,33 @@
+package nulls
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUInt64_Value(t *testing.T) {
+	v, err := NewUInt64(42).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+
+	v, err = NewUInt64(math.MaxUint64).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "18446744073709551615", v)
+
+	var ns UInt64
+	assert.NoError(t, ns.Scan(v))
+	assert.Equal(t, NewUInt64(math.MaxUint64), ns)
+}
+
+func TestUInt64_Overflow(t *testing.T) {
+	var ns UInt64
+	assert.Error(t, ns.Scan(int64(-1)))
+	assert.Error(t, ns.Scan("18446744073709551616"))
+	assert.Error(t, json.Unmarshal([]byte("-1"), &ns))
+
+	assert.NoError(t, json.Unmarshal([]byte("18446744073709551615"), &ns))
+	assert.Equal(t, NewUInt64(math.MaxUint64), ns)
+}
@@ -0,0 +1,88 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// UInt8 adds an implementation for uint8
+// that supports proper JSON encoding/decoding.
+type UInt8 struct {
+	UInt8 uint8
+	Valid bool // Valid is true if UInt8 is not NULL
+}
+
+// Interface implements the nullable interface. It returns nil if
+// the uint8 is not valid, otherwise it returns the uint8 value.
+func (ns UInt8) Interface() interface{} {
+	return ns.null().Interface()
+}
+
+// NewUInt8 returns a new, properly instantiated
+// UInt8 object.
+func NewUInt8(i uint8) UInt8 {
+	return UInt8{UInt8: i, Valid: true}
+}
+
+func (ns UInt8) null() Null[uint8] {
+	return Null[uint8]{V: ns.UInt8, Valid: ns.Valid}
+}
+
+// Scan implements the Scanner interface.
+func (ns *UInt8) Scan(value interface{}) error {
+	n := ns.null()
+	err := n.Scan(value)
+	ns.UInt8, ns.Valid = n.V, n.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (ns UInt8) Value() (driver.Value, error) {
+	return ns.null().Value()
+}
+
+// MarshalJSON marshals the underlying value to a
+// proper JSON representation.
+func (ns UInt8) MarshalJSON() ([]byte, error) {
+	return ns.null().MarshalJSON()
+}
+
+// UnmarshalJSON will unmarshal a JSON value into
+// the propert representation of that value.
+func (ns *UInt8) UnmarshalJSON(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalJSON(text)
+	ns.UInt8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+// UnmarshalText will unmarshal text value into
+// the propert representation of that value.
+func (ns *UInt8) UnmarshalText(text []byte) error {
+	n := ns.null()
+	err := n.UnmarshalText(text)
+	ns.UInt8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ns.null().MarshalXML(e, start)
+}
+
+func (ns *UInt8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n := ns.null()
+	err := n.UnmarshalXML(d, start)
+	ns.UInt8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
+
+func (ns UInt8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return ns.null().MarshalXMLAttr(name)
+}
+
+func (ns *UInt8) UnmarshalXMLAttr(attr xml.Attr) error {
+	n := ns.null()
+	err := n.UnmarshalXMLAttr(attr)
+	ns.UInt8, ns.Valid = n.V, n.Valid
+	return decoded(ns, err)
+}
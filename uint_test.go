@@ -0,0 +1,25 @@
+package nulls
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUInt_Value(t *testing.T) {
+	v, err := NewUInt(42).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+
+	v, err = NewUInt(math.MaxUint).Value()
+	assert.NoError(t, err)
+	if strconv.IntSize == 64 {
+		assert.Equal(t, "18446744073709551615", v)
+	}
+
+	var ns UInt
+	assert.NoError(t, ns.Scan(v))
+	assert.Equal(t, NewUInt(math.MaxUint), ns)
+}
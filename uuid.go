@@ -2,7 +2,7 @@ package nulls
 
 import (
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"strings"
 
 	"github.com/gobuffalo/uuid"
@@ -19,10 +19,7 @@ type UUID struct {
 // Interface implements the nullable interface. It returns nil if
 // the UUID is not valid, otherwise it returns the UUID value.
 func (u UUID) Interface() interface{} {
-	if !u.Valid {
-		return nil
-	}
-	return u.UUID
+	return u.null().Interface()
 }
 
 // NewUUID returns a new, properly instantiated
@@ -31,34 +28,29 @@ func NewUUID(u uuid.UUID) UUID {
 	return UUID{UUID: u, Valid: true}
 }
 
+func (u UUID) null() Null[uuid.UUID] {
+	return Null[uuid.UUID]{V: u.UUID, Valid: u.Valid}
+}
+
 // Value implements the driver.Valuer interface.
 func (u UUID) Value() (driver.Value, error) {
-	if !u.Valid {
-		return nil, nil
-	}
-	// Delegate to UUID Value function
-	return u.UUID.Value()
+	// Delegates to UUID Value function
+	return u.null().Value()
 }
 
 // Scan implements the sql.Scanner interface.
 func (u *UUID) Scan(src interface{}) error {
-	if src == nil {
-		u.UUID, u.Valid = uuid.Nil, false
-		return nil
-	}
-
-	// Delegate to UUID Scan function
-	u.Valid = true
-	return u.UUID.Scan(src)
+	// Delegates to UUID Scan function
+	n := Null[uuid.UUID]{}
+	err := n.Scan(src)
+	u.UUID, u.Valid = n.V, n.Valid
+	return err
 }
 
 // MarshalJSON marshals the underlying value to a
 // proper JSON representation.
 func (u UUID) MarshalJSON() ([]byte, error) {
-	if u.Valid {
-		return json.Marshal(u.UUID.String())
-	}
-	return json.Marshal(nil)
+	return u.null().MarshalJSON()
 }
 
 // UnmarshalJSON will unmarshal a JSON value into
@@ -76,7 +68,7 @@ func (u *UUID) UnmarshalJSON(text []byte) error {
 
 	us, err := uuid.FromString(s)
 	if err != nil {
-		return errors.WithStack(err)
+		return decoded(u, &DecodeError{Type: "UUID", Text: string(text), Err: errors.WithStack(err)})
 	}
 	u.UUID = us
 	u.Valid = true
@@ -89,3 +81,25 @@ func (u *UUID) UnmarshalJSON(text []byte) error {
 func (u *UUID) UnmarshalText(text []byte) error {
 	return u.UnmarshalJSON(text)
 }
+
+func (u UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return u.null().MarshalXML(e, start)
+}
+
+func (u *UUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n := u.null()
+	err := n.UnmarshalXML(d, start)
+	u.UUID, u.Valid = n.V, n.Valid
+	return decoded(u, err)
+}
+
+func (u UUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return u.null().MarshalXMLAttr(name)
+}
+
+func (u *UUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	n := u.null()
+	err := n.UnmarshalXMLAttr(attr)
+	u.UUID, u.Valid = n.V, n.Valid
+	return decoded(u, err)
+}
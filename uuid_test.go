@@ -2,6 +2,7 @@ package nulls
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"testing"
 
 	uuid "github.com/gobuffalo/uuid"
@@ -23,3 +24,41 @@ func Test_UUID_UnmarshalJSON(t *testing.T) {
 	r.True(nid.Valid)
 	r.Equal(id.String(), nid.UUID.String())
 }
+
+func Test_UUID_MarshalXML(t *testing.T) {
+	r := require.New(t)
+	id, err := uuid.NewV4()
+	r.NoError(err)
+
+	type test struct {
+		Elem UUID `xml:"elem"`
+		Attr UUID `xml:"attr,attr"`
+		None UUID `xml:"none"`
+	}
+
+	b, err := xml.Marshal(test{Elem: NewUUID(id), Attr: NewUUID(id)})
+	r.NoError(err)
+	r.Equal(`<test attr="`+id.String()+`"><elem>`+id.String()+`</elem></test>`, string(b))
+
+	val := test{}
+	r.NoError(xml.Unmarshal(b, &val))
+	r.Equal(NewUUID(id), val.Elem)
+	r.Equal(NewUUID(id), val.Attr)
+	r.False(val.None.Valid)
+}
+
+func Test_UUID_UnmarshalXML_Null(t *testing.T) {
+	r := require.New(t)
+
+	type test struct {
+		Elem UUID `xml:"elem"`
+		Attr UUID `xml:"attr,attr"`
+	}
+
+	val := test{}
+	r.NoError(xml.Unmarshal([]byte(`<test attr="null"><elem></elem></test>`), &val))
+	r.False(val.Elem.Valid)
+	r.False(val.Attr.Valid)
+
+	r.Error(xml.Unmarshal([]byte(`<test><elem>foo</elem></test>`), &val))
+}